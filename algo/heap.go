@@ -0,0 +1,105 @@
+// Copyright 2021. The GTL Authors. All rights reserved.
+// https://github.com/modern-dev/gtl
+// Use of this source code is governed by the MIT
+// license that can be found in the LICENSE file.
+
+// Package algo provides algorithms operating on slices and other sequences.
+package algo
+
+import (
+	"github.com/modern-dev/gtl/utility"
+)
+
+// MakeHeap rearranges the elements of data so that they form a max heap with respect to the comparator,
+// e.g. data[0] is the largest element and no child is greater than its parent.
+// Complexity - O(n), where n is the length of data.
+func MakeHeap[T any](data []T, cmp utility.Compare[T]) {
+	n := len(data)
+
+	for i := n/2 - 1; i >= 0; i-- {
+		siftDown(data, i, n, cmp)
+	}
+}
+
+// PushHeap inserts the last element of data into the max heap formed by data[:len(data)-1].
+// After the call the whole data forms a max heap.
+// Complexity - O(log n), where n is the length of data.
+func PushHeap[T any](data []T, cmp utility.Compare[T]) {
+	siftUp(data, len(data)-1, cmp)
+}
+
+// PopHeap swaps the largest element data[0] with the last element of data and makes data[:len(data)-1] a max heap.
+// The popped element can then be taken from data[len(data)-1].
+// Complexity - O(log n), where n is the length of data.
+func PopHeap[T any](data []T, cmp utility.Compare[T]) {
+	n := len(data) - 1
+
+	if n <= 0 {
+		return
+	}
+
+	data[0], data[n] = data[n], data[0]
+
+	siftDown(data, 0, n, cmp)
+}
+
+// SortHeap converts the max heap data into a slice sorted in ascending order with respect to the comparator.
+// The resulting slice no longer forms a heap.
+// Complexity - O(n log n), where n is the length of data.
+func SortHeap[T any](data []T, cmp utility.Compare[T]) {
+	for n := len(data); n > 1; n-- {
+		PopHeap(data[:n], cmp)
+	}
+}
+
+// IsHeap checks if data forms a max heap with respect to the comparator.
+// Complexity - O(n), where n is the length of data.
+func IsHeap[T any](data []T, cmp utility.Compare[T]) bool {
+	return IsHeapUntil(data, cmp) == len(data)
+}
+
+// IsHeapUntil returns the length of the largest prefix of data that forms a max heap with respect to the comparator.
+// Complexity - O(n), where n is the length of data.
+func IsHeapUntil[T any](data []T, cmp utility.Compare[T]) int {
+	for i := 1; i < len(data); i++ {
+		if cmp.Cmp(data[(i-1)/2], data[i]) {
+			return i
+		}
+	}
+
+	return len(data)
+}
+
+func siftUp[T any](data []T, i int, cmp utility.Compare[T]) {
+	for i > 0 {
+		parent := (i - 1) / 2
+
+		if !cmp.Cmp(data[parent], data[i]) {
+			return
+		}
+
+		data[i], data[parent] = data[parent], data[i]
+		i = parent
+	}
+}
+
+func siftDown[T any](data []T, i, n int, cmp utility.Compare[T]) {
+	for {
+		child := 2*i + 1
+
+		if child >= n {
+			return
+		}
+
+		if child+1 < n && cmp.Cmp(data[child], data[child+1]) {
+			child++
+		}
+
+		if !cmp.Cmp(data[i], data[child]) {
+			return
+		}
+
+		data[i], data[child] = data[child], data[i]
+		i = child
+	}
+}
@@ -0,0 +1,85 @@
+// Copyright 2021. The GTL Authors. All rights reserved.
+// https://github.com/modern-dev/gtl
+// Use of this source code is governed by the MIT
+// license that can be found in the LICENSE file.
+
+package algo
+
+import (
+	"math/rand"
+	"sort"
+	"testing"
+
+	"github.com/modern-dev/gtl/utility"
+)
+
+func TestMakeHeap(t *testing.T) {
+	data := rand.New(rand.NewSource(42)).Perm(100)
+
+	MakeHeap[int](data, &utility.Less[int]{})
+
+	if !IsHeap[int](data, &utility.Less[int]{}) {
+		t.Errorf("Expected %v to be a heap", data)
+	}
+
+	if data[0] != 99 {
+		t.Errorf("Expected the top of the heap to be %d, got %d", 99, data[0])
+	}
+}
+
+func TestPushPopHeap(t *testing.T) {
+	var (
+		data []int
+		cmp  = &utility.Greater[int]{}
+	)
+
+	for _, v := range []int{5, 6, 7, 9, 14, 11, 10, 5} {
+		data = append(data, v)
+		PushHeap[int](data, cmp)
+
+		if !IsHeap[int](data, cmp) {
+			t.Fatalf("Expected %v to be a heap", data)
+		}
+	}
+
+	for _, want := range []int{5, 5, 6, 7, 9, 10, 11, 14} {
+		PopHeap[int](data, cmp)
+
+		if got := data[len(data)-1]; got != want {
+			t.Errorf("Expected to pop %d, got %d", want, got)
+		}
+
+		data = data[:len(data)-1]
+	}
+}
+
+func TestSortHeap(t *testing.T) {
+	data := rand.New(rand.NewSource(7)).Perm(257)
+	cmp := &utility.Less[int]{}
+
+	MakeHeap[int](data, cmp)
+	SortHeap[int](data, cmp)
+
+	if !sort.IntsAreSorted(data) {
+		t.Errorf("Expected %v to be sorted", data)
+	}
+}
+
+func TestIsHeapUntil(t *testing.T) {
+	cases := []struct {
+		data     []int
+		expected int
+	}{
+		{[]int{}, 0},
+		{[]int{1}, 1},
+		{[]int{9, 5, 4, 1, 1, 3}, 6},
+		{[]int{9, 5, 4, 1, 6, 3}, 4},
+		{[]int{1, 2}, 1},
+	}
+
+	for _, c := range cases {
+		if got := IsHeapUntil[int](c.data, &utility.Less[int]{}); got != c.expected {
+			t.Errorf("IsHeapUntil(%v) = %d, want %d", c.data, got, c.expected)
+		}
+	}
+}
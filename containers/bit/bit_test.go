@@ -76,3 +76,43 @@ func min(a, b int) int {
 
 	return b
 }
+
+func TestBITEncoding(t *testing.T) {
+	bit := NewBIT(17)
+
+	for i := 1; i < bit.Len(); i++ {
+		bit.Update(i, i)
+	}
+
+	bin, err := bit.MarshalBinary()
+	if err != nil {
+		t.Fatalf("MarshalBinary() failed: %v", err)
+	}
+
+	js, err := bit.MarshalJSON()
+	if err != nil {
+		t.Fatalf("MarshalJSON() failed: %v", err)
+	}
+
+	fromBin, fromJSON := &BIT{}, &BIT{}
+
+	if err := fromBin.UnmarshalBinary(bin); err != nil {
+		t.Fatalf("UnmarshalBinary() failed: %v", err)
+	}
+
+	if err := fromJSON.UnmarshalJSON(js); err != nil {
+		t.Fatalf("UnmarshalJSON() failed: %v", err)
+	}
+
+	for _, restored := range []*BIT{fromBin, fromJSON} {
+		if restored.Len() != bit.Len() {
+			t.Errorf("Expected to get %d, got %d", bit.Len(), restored.Len())
+		}
+
+		for i := 0; i < bit.Len(); i++ {
+			if restored.Query(i) != bit.Query(i) {
+				t.Errorf("Expected to get %d, got %d", bit.Query(i), restored.Query(i))
+			}
+		}
+	}
+}
@@ -0,0 +1,57 @@
+// Copyright 2021. The GTL Authors. All rights reserved.
+// https://github.com/modern-dev/gtl
+// Use of this source code is governed by the MIT
+// license that can be found in the LICENSE file.
+
+package bit
+
+import (
+	"encoding/json"
+
+	"github.com/modern-dev/gtl/internal/serial"
+)
+
+// binaryVersion is the version of the format produced by MarshalBinary.
+const binaryVersion byte = 1
+
+// MarshalBinary implements the encoding.BinaryMarshaler interface.
+func (this *BIT) MarshalBinary() ([]byte, error) {
+	return serial.Marshal(binaryVersion, this.tree)
+}
+
+// UnmarshalBinary implements the encoding.BinaryUnmarshaler interface.
+func (this *BIT) UnmarshalBinary(data []byte) error {
+	tree, err := serial.Unmarshal[[]int](data, binaryVersion)
+
+	if err != nil {
+		return err
+	}
+
+	this.assign(tree)
+
+	return nil
+}
+
+// MarshalJSON implements the json.Marshaler interface.
+// The BIT is encoded as a JSON array of its internal nodes.
+func (this *BIT) MarshalJSON() ([]byte, error) {
+	return json.Marshal(append([]int{}, this.tree...))
+}
+
+// UnmarshalJSON implements the json.Unmarshaler interface.
+func (this *BIT) UnmarshalJSON(data []byte) error {
+	var tree []int
+
+	if err := json.Unmarshal(data, &tree); err != nil {
+		return err
+	}
+
+	this.assign(tree)
+
+	return nil
+}
+
+func (this *BIT) assign(tree []int) {
+	this.tree = append([]int{}, tree...)
+	this.size = len(tree)
+}
@@ -0,0 +1,252 @@
+// Copyright 2021. The GTL Authors. All rights reserved.
+// https://github.com/modern-dev/gtl
+// Use of this source code is governed by the MIT
+// license that can be found in the LICENSE file.
+
+package cache
+
+// ARC is an Adaptive Replacement Cache, see https://www.usenix.org/legacy/events/fast03/tech/full_papers/megiddo/megiddo.pdf.
+// It balances between recency and frequency: the entries used once live in the recent list t1,
+// the entries used at least twice live in the frequent list t2, and the keys recently evicted from them
+// are remembered in the ghost lists b1 and b2. A hit in a ghost list adapts the target size p of t1.
+// It is not safe for concurrent use, see Synchronized.
+type ARC[K comparable, V any] struct {
+	base[K, V]
+	items          map[K]*entry[K, V]
+	t1, t2, b1, b2 *list[K, V]
+	p              int
+}
+
+// NewARC creates an empty ARC holding at most capacity entries.
+func NewARC[K comparable, V any](capacity int) *ARC[K, V] {
+	return NewARCWithOptions[K, V](capacity, Options[K, V]{})
+}
+
+// NewARCWithOptions creates an empty ARC holding at most capacity entries.
+func NewARCWithOptions[K comparable, V any](capacity int, opts Options[K, V]) *ARC[K, V] {
+	c := &ARC[K, V]{base: newBase(capacity, opts)}
+	c.Clear()
+
+	return c
+}
+
+// Size returns the number of entries in the cache, including the expired ones that were not dropped yet.
+// The keys remembered in the ghost lists are not counted.
+// Complexity - O(1).
+func (c *ARC[K, V]) Size() int {
+	return c.t1.len + c.t2.len
+}
+
+// Get returns the value stored for the key and promotes the entry to the frequent list.
+// Complexity - O(1).
+func (c *ARC[K, V]) Get(key K) (V, bool) {
+	e, ok := c.lookup(key)
+
+	if !ok {
+		var emptyVal V
+
+		return emptyVal, false
+	}
+
+	c.promote(e)
+
+	return e.value, true
+}
+
+// Peek returns the value stored for the key without marking the entry as used.
+// Complexity - O(1).
+func (c *ARC[K, V]) Peek(key K) (V, bool) {
+	e, ok := c.lookup(key)
+
+	if !ok {
+		var emptyVal V
+
+		return emptyVal, false
+	}
+
+	return e.value, true
+}
+
+// Contains checks if the cache holds an entry for the key without marking it as used.
+// Complexity - O(1).
+func (c *ARC[K, V]) Contains(key K) bool {
+	_, ok := c.lookup(key)
+
+	return ok
+}
+
+// Put stores the value for the key.
+// A new key goes to the recent list, unless it is remembered in a ghost list, then it goes to the frequent list.
+// If the cache is full, an entry is evicted from either list according to the adaptive target.
+// Complexity - O(1).
+func (c *ARC[K, V]) Put(key K, value V) {
+	e, ok := c.items[key]
+
+	switch {
+	case ok && (e.owner == c.t1 || e.owner == c.t2):
+		e.value, e.expiresAt = value, c.deadline()
+		c.promote(e)
+
+		return
+	case ok && e.owner == c.b1:
+		c.p = minInt(c.capacity, c.p+maxInt(c.b2.len/c.b1.len, 1))
+		c.replace(false)
+		c.b1.remove(e)
+	case ok && e.owner == c.b2:
+		c.p = maxInt(0, c.p-maxInt(c.b1.len/c.b2.len, 1))
+		c.replace(true)
+		c.b2.remove(e)
+	default:
+		if l1 := c.t1.len + c.b1.len; l1 >= c.capacity {
+			if c.t1.len < c.capacity {
+				c.forget(c.b1)
+				c.replace(false)
+			} else {
+				victim := c.t1.back()
+
+				c.t1.remove(victim)
+				delete(c.items, victim.key)
+				c.evicted(victim)
+			}
+		} else if total := l1 + c.t2.len + c.b2.len; total >= c.capacity {
+			if total >= 2*c.capacity {
+				c.forget(c.b2)
+			}
+
+			c.replace(false)
+		}
+
+		e = &entry[K, V]{key: key}
+		c.items[key] = e
+		c.t1.pushFront(e)
+		e.value, e.expiresAt = value, c.deadline()
+
+		return
+	}
+
+	e.value, e.expiresAt = value, c.deadline()
+	c.t2.pushFront(e)
+}
+
+// Remove deletes the entry for the key. Returns true if the cache held the entry.
+// Complexity - O(1).
+func (c *ARC[K, V]) Remove(key K) bool {
+	e, ok := c.items[key]
+
+	if !ok {
+		return false
+	}
+
+	resident := e.owner == c.t1 || e.owner == c.t2
+
+	e.owner.remove(e)
+	delete(c.items, key)
+
+	return resident
+}
+
+// RemoveExpired drops all expired entries and returns their number.
+// Complexity - O(n), where n is the number of entries in the cache.
+func (c *ARC[K, V]) RemoveExpired() int {
+	var expired []*entry[K, V]
+
+	for _, e := range c.items {
+		if (e.owner == c.t1 || e.owner == c.t2) && c.expired(e) {
+			expired = append(expired, e)
+		}
+	}
+
+	for _, e := range expired {
+		e.owner.remove(e)
+		delete(c.items, e.key)
+		c.evicted(e)
+	}
+
+	return len(expired)
+}
+
+// Clear removes all entries from the cache and resets its adaptation.
+// Complexity - O(1).
+func (c *ARC[K, V]) Clear() {
+	c.items = make(map[K]*entry[K, V])
+	c.t1, c.t2 = newList[K, V](), newList[K, V]()
+	c.b1, c.b2 = newList[K, V](), newList[K, V]()
+	c.p = 0
+}
+
+// lookup returns the live resident entry for the key, dropping it if it expired.
+func (c *ARC[K, V]) lookup(key K) (*entry[K, V], bool) {
+	e, ok := c.items[key]
+
+	if !ok || e.owner == c.b1 || e.owner == c.b2 {
+		return nil, false
+	}
+
+	if c.expired(e) {
+		e.owner.remove(e)
+		delete(c.items, key)
+		c.evicted(e)
+
+		return nil, false
+	}
+
+	return e, true
+}
+
+// promote moves a resident entry to the front of the frequent list.
+func (c *ARC[K, V]) promote(e *entry[K, V]) {
+	e.owner.remove(e)
+	c.t2.pushFront(e)
+}
+
+// replace evicts the least recently used entry of either t1 or t2 into the corresponding ghost list,
+// depending on the adaptive target size of t1. inB2 tells that the requested key was found in b2.
+func (c *ARC[K, V]) replace(inB2 bool) {
+	if c.Size() < c.capacity {
+		return
+	}
+
+	from, to := c.t2, c.b2
+
+	if c.t1.len > 0 && (c.t1.len > c.p || (inB2 && c.t1.len == c.p) || c.t2.len == 0) {
+		from, to = c.t1, c.b1
+	}
+
+	victim := from.back()
+
+	if victim == nil {
+		return
+	}
+
+	from.remove(victim)
+	c.evicted(victim)
+
+	var emptyVal V
+
+	victim.value = emptyVal
+	to.pushFront(victim)
+}
+
+// forget drops the least recently used key of a ghost list.
+func (c *ARC[K, V]) forget(ghost *list[K, V]) {
+	if victim := ghost.back(); victim != nil {
+		ghost.remove(victim)
+		delete(c.items, victim.key)
+	}
+}
+
+func minInt(a, b int) int {
+	if a < b {
+		return a
+	}
+
+	return b
+}
+
+func maxInt(a, b int) int {
+	if a > b {
+		return a
+	}
+
+	return b
+}
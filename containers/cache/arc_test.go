@@ -0,0 +1,101 @@
+// Copyright 2021. The GTL Authors. All rights reserved.
+// https://github.com/modern-dev/gtl
+// Use of this source code is governed by the MIT
+// license that can be found in the LICENSE file.
+
+package cache
+
+import (
+	"math/rand"
+	"testing"
+)
+
+var _ Cache[int, int] = NewARC[int, int](1)
+
+func TestARC(t *testing.T) {
+	var evicted []int
+
+	c := NewARCWithOptions[int, int](2, Options[int, int]{
+		OnEvict: func(key int, _ int) { evicted = append(evicted, key) },
+	})
+
+	c.Put(1, 1)
+	c.Put(2, 2)
+	c.Get(1)
+	c.Put(3, 3)
+
+	// 2 was used once and is evicted from the recent list
+	checkCache[int, int](c, map[int]int{1: 1, 3: 3}, []int{2}, t)
+
+	// 2 is remembered in the ghost list and comes back as frequent,
+	// the ghost hit grows the target of the recent list, so 1 is evicted from the frequent one
+	c.Put(2, 20)
+	checkCache[int, int](c, map[int]int{2: 20, 3: 3}, []int{1}, t)
+	checkEvicted(evicted, []int{2, 1}, t)
+
+	if c.p != 1 {
+		t.Errorf("Expected target %d, got %d", 1, c.p)
+	}
+}
+
+func TestARCScanResistance(t *testing.T) {
+	const capacity = 10
+
+	c := NewARC[int, int](capacity)
+
+	// the hot keys are used twice and move to the frequent list
+	for round := 0; round < 2; round++ {
+		for key := 0; key < capacity/2; key++ {
+			if _, ok := c.Get(key); !ok {
+				c.Put(key, key)
+			}
+		}
+	}
+
+	// a long scan of keys used once must not flush the hot keys
+	for key := 1000; key < 1100; key++ {
+		c.Put(key, key)
+	}
+
+	for key := 0; key < capacity/2; key++ {
+		if !c.Contains(key) {
+			t.Errorf("Expected hot key %d to survive the scan", key)
+		}
+	}
+}
+
+func TestARCInvariants(t *testing.T) {
+	const capacity = 16
+
+	rnd := rand.New(rand.NewSource(42))
+	c := NewARC[int, int](capacity)
+
+	for i := 0; i < 10000; i++ {
+		key := rnd.Intn(64)
+
+		switch rnd.Intn(4) {
+		case 0:
+			c.Remove(key)
+		case 1:
+			c.Get(key)
+		default:
+			c.Put(key, key)
+		}
+
+		if c.Size() > capacity {
+			t.Fatalf("Expected at most %d entries, got %d", capacity, c.Size())
+		}
+
+		if c.t1.len+c.b1.len > capacity || c.Size()+c.b1.len+c.b2.len > 2*capacity {
+			t.Fatalf("ARC directory is too large: t1=%d t2=%d b1=%d b2=%d", c.t1.len, c.t2.len, c.b1.len, c.b2.len)
+		}
+
+		if c.p < 0 || c.p > capacity {
+			t.Fatalf("Expected target in [0, %d], got %d", capacity, c.p)
+		}
+
+		if got, ok := c.Peek(key); ok && got != key {
+			t.Fatalf("Expected Peek(%d) to return %d, got %d", key, key, got)
+		}
+	}
+}
@@ -0,0 +1,82 @@
+// Copyright 2021. The GTL Authors. All rights reserved.
+// https://github.com/modern-dev/gtl
+// Use of this source code is governed by the MIT
+// license that can be found in the LICENSE file.
+
+// Package cache provides bounded key-value caches with different eviction policies.
+package cache
+
+import (
+	"time"
+)
+
+type (
+	// Cache is implemented by every cache of the package.
+	// Get counts as a use of the entry for the eviction policy, while Peek and Contains do not.
+	Cache[K comparable, V any] interface {
+		Get(key K) (V, bool)
+		Peek(key K) (V, bool)
+		Contains(key K) bool
+		Put(key K, value V)
+		Remove(key K) bool
+		RemoveExpired() int
+		Size() int
+		Capacity() int
+		Clear()
+	}
+
+	// Options tunes the behavior of a cache.
+	Options[K comparable, V any] struct {
+		// OnEvict is called with every entry dropped by the cache to make room for a new one or because it expired.
+		// It is not called for the entries removed with Remove or Clear, or replaced with Put.
+		OnEvict func(key K, value V)
+		// TTL is the time after an entry was put when it expires, zero means that entries never expire.
+		// Expired entries are dropped lazily when they are accessed or by RemoveExpired.
+		TTL time.Duration
+		// Clock returns the current time, time.Now is used if it is nil.
+		Clock func() time.Time
+	}
+
+	// base holds the state shared by all caches.
+	base[K comparable, V any] struct {
+		capacity int
+		opts     Options[K, V]
+	}
+)
+
+func newBase[K comparable, V any](capacity int, opts Options[K, V]) base[K, V] {
+	if capacity <= 0 {
+		panic("cache: capacity must be positive")
+	}
+
+	if opts.Clock == nil {
+		opts.Clock = time.Now
+	}
+
+	return base[K, V]{capacity, opts}
+}
+
+// Capacity returns the maximum number of entries in the cache.
+// Complexity - O(1).
+func (b *base[K, V]) Capacity() int {
+	return b.capacity
+}
+
+// deadline returns the expiration time of an entry put now, zero if entries never expire.
+func (b *base[K, V]) deadline() time.Time {
+	if b.opts.TTL <= 0 {
+		return time.Time{}
+	}
+
+	return b.opts.Clock().Add(b.opts.TTL)
+}
+
+func (b *base[K, V]) expired(e *entry[K, V]) bool {
+	return !e.expiresAt.IsZero() && !b.opts.Clock().Before(e.expiresAt)
+}
+
+func (b *base[K, V]) evicted(e *entry[K, V]) {
+	if b.opts.OnEvict != nil {
+		b.opts.OnEvict(e.key, e.value)
+	}
+}
@@ -0,0 +1,219 @@
+// Copyright 2021. The GTL Authors. All rights reserved.
+// https://github.com/modern-dev/gtl
+// Use of this source code is governed by the MIT
+// license that can be found in the LICENSE file.
+
+package cache
+
+// LFU is a cache that evicts the least frequently used entry when it runs out of capacity.
+// Of several least frequently used entries the least recently used one is evicted.
+// The entries are kept in recency lists bucketed by the use count, which makes every operation constant time.
+// It is not safe for concurrent use, see Synchronized.
+type LFU[K comparable, V any] struct {
+	base[K, V]
+	items   map[K]*entry[K, V]
+	freqs   map[int]*list[K, V]
+	minFreq int
+}
+
+// NewLFU creates an empty LFU holding at most capacity entries.
+func NewLFU[K comparable, V any](capacity int) *LFU[K, V] {
+	return NewLFUWithOptions[K, V](capacity, Options[K, V]{})
+}
+
+// NewLFUWithOptions creates an empty LFU holding at most capacity entries.
+func NewLFUWithOptions[K comparable, V any](capacity int, opts Options[K, V]) *LFU[K, V] {
+	return &LFU[K, V]{
+		base:  newBase(capacity, opts),
+		items: make(map[K]*entry[K, V]),
+		freqs: make(map[int]*list[K, V]),
+	}
+}
+
+// Size returns the number of entries in the cache, including the expired ones that were not dropped yet.
+// Complexity - O(1).
+func (c *LFU[K, V]) Size() int {
+	return len(c.items)
+}
+
+// Get returns the value stored for the key and increments the use count of the entry.
+// Complexity - O(1).
+func (c *LFU[K, V]) Get(key K) (V, bool) {
+	e, ok := c.lookup(key)
+
+	if !ok {
+		var emptyVal V
+
+		return emptyVal, false
+	}
+
+	c.touch(e)
+
+	return e.value, true
+}
+
+// Peek returns the value stored for the key without incrementing the use count of the entry.
+// Complexity - O(1).
+func (c *LFU[K, V]) Peek(key K) (V, bool) {
+	e, ok := c.lookup(key)
+
+	if !ok {
+		var emptyVal V
+
+		return emptyVal, false
+	}
+
+	return e.value, true
+}
+
+// Contains checks if the cache holds an entry for the key without incrementing its use count.
+// Complexity - O(1).
+func (c *LFU[K, V]) Contains(key K) bool {
+	_, ok := c.lookup(key)
+
+	return ok
+}
+
+// Put stores the value for the key and increments the use count of the entry.
+// If the cache is full, the least frequently used entry is evicted.
+// Complexity - O(1).
+func (c *LFU[K, V]) Put(key K, value V) {
+	if e, ok := c.items[key]; ok {
+		e.value, e.expiresAt = value, c.deadline()
+		c.touch(e)
+
+		return
+	}
+
+	if len(c.items) >= c.capacity {
+		victim := c.bucket(c.lowestFreq()).back()
+
+		c.drop(victim)
+		c.evicted(victim)
+	}
+
+	e := &entry[K, V]{key: key, value: value, expiresAt: c.deadline(), freq: 1}
+	c.items[key] = e
+	c.bucket(1).pushFront(e)
+	c.minFreq = 1
+}
+
+// Remove deletes the entry for the key. Returns true if the cache held the entry.
+// Complexity - O(1).
+func (c *LFU[K, V]) Remove(key K) bool {
+	e, ok := c.items[key]
+
+	if ok {
+		c.drop(e)
+	}
+
+	return ok
+}
+
+// RemoveExpired drops all expired entries and returns their number.
+// Complexity - O(n), where n is the number of entries in the cache.
+func (c *LFU[K, V]) RemoveExpired() int {
+	var expired []*entry[K, V]
+
+	for _, e := range c.items {
+		if c.expired(e) {
+			expired = append(expired, e)
+		}
+	}
+
+	for _, e := range expired {
+		c.drop(e)
+		c.evicted(e)
+	}
+
+	return len(expired)
+}
+
+// Clear removes all entries from the cache.
+// Complexity - O(1).
+func (c *LFU[K, V]) Clear() {
+	c.items = make(map[K]*entry[K, V])
+	c.freqs = make(map[int]*list[K, V])
+	c.minFreq = 0
+}
+
+// Frequency returns the use count of the entry for the key, zero if the cache holds no such entry.
+// Complexity - O(1).
+func (c *LFU[K, V]) Frequency(key K) int {
+	if e, ok := c.lookup(key); ok {
+		return e.freq
+	}
+
+	return 0
+}
+
+// lookup returns the live entry for the key, dropping it if it expired.
+func (c *LFU[K, V]) lookup(key K) (*entry[K, V], bool) {
+	e, ok := c.items[key]
+
+	if !ok {
+		return nil, false
+	}
+
+	if c.expired(e) {
+		c.drop(e)
+		c.evicted(e)
+
+		return nil, false
+	}
+
+	return e, true
+}
+
+// touch moves the entry to the bucket of the next use count.
+func (c *LFU[K, V]) touch(e *entry[K, V]) {
+	c.unlink(e)
+
+	if _, ok := c.freqs[c.minFreq]; !ok && c.minFreq == e.freq {
+		c.minFreq++
+	}
+
+	e.freq++
+	c.bucket(e.freq).pushFront(e)
+}
+
+func (c *LFU[K, V]) bucket(freq int) *list[K, V] {
+	l, ok := c.freqs[freq]
+
+	if !ok {
+		l = newList[K, V]()
+		c.freqs[freq] = l
+	}
+
+	return l
+}
+
+// lowestFreq returns the lowest use count among the entries.
+// The cached minimum may go stale when an entry is removed explicitly, then the buckets are scanned.
+func (c *LFU[K, V]) lowestFreq() int {
+	if _, ok := c.freqs[c.minFreq]; !ok {
+		c.minFreq = 0
+
+		for freq := range c.freqs {
+			if c.minFreq == 0 || freq < c.minFreq {
+				c.minFreq = freq
+			}
+		}
+	}
+
+	return c.minFreq
+}
+
+func (c *LFU[K, V]) unlink(e *entry[K, V]) {
+	l := e.owner
+	l.remove(e)
+
+	if l.len == 0 {
+		delete(c.freqs, e.freq)
+	}
+}
+
+func (c *LFU[K, V]) drop(e *entry[K, V]) {
+	c.unlink(e)
+	delete(c.items, e.key)
+}
@@ -0,0 +1,64 @@
+// Copyright 2021. The GTL Authors. All rights reserved.
+// https://github.com/modern-dev/gtl
+// Use of this source code is governed by the MIT
+// license that can be found in the LICENSE file.
+
+package cache
+
+import (
+	"testing"
+	"time"
+)
+
+var _ Cache[int, int] = NewLFU[int, int](1)
+
+func TestLFU(t *testing.T) {
+	var evicted []string
+
+	c := NewLFUWithOptions[string, int](3, Options[string, int]{
+		OnEvict: func(key string, _ int) { evicted = append(evicted, key) },
+	})
+
+	c.Put("a", 1)
+	c.Put("b", 2)
+	c.Put("c", 3)
+	c.Get("a")
+	c.Get("a")
+	c.Get("b")
+
+	// "c" is the least frequently used
+	c.Put("d", 4)
+	checkCache[string, int](c, map[string]int{"a": 1, "b": 2, "d": 4}, []string{"c"}, t)
+
+	// "d" is the least frequently used, the ties are broken by recency
+	c.Put("e", 5)
+	c.Get("e")
+	c.Put("f", 6)
+	checkCache[string, int](c, map[string]int{"a": 1, "e": 5, "f": 6}, []string{"b", "d"}, t)
+	checkEvicted(evicted, []string{"c", "d", "b"}, t)
+
+	if c.Frequency("a") != 3 || c.Frequency("f") != 1 || c.Frequency("z") != 0 {
+		t.Errorf("Expected frequencies [3, 1, 0], got [%d, %d, %d]", c.Frequency("a"), c.Frequency("f"), c.Frequency("z"))
+	}
+
+	// the cached minimum goes stale after an explicit removal
+	c.Remove("f")
+	c.Put("g", 7)
+	c.Put("h", 8)
+	checkCache[string, int](c, map[string]int{"a": 1, "e": 5, "h": 8}, []string{"g"}, t)
+}
+
+func TestLFUTTL(t *testing.T) {
+	clock := &fakeClock{time.Unix(0, 0)}
+	c := NewLFUWithOptions[int, int](2, Options[int, int]{TTL: time.Second, Clock: clock.Now})
+
+	c.Put(1, 1)
+	c.Get(1)
+	clock.Advance(time.Second)
+
+	if _, ok := c.Get(1); ok {
+		t.Errorf("Expected expired entry to be dropped")
+	}
+
+	checkCache[int, int](c, map[int]int{}, []int{1}, t)
+}
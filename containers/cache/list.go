@@ -0,0 +1,70 @@
+// Copyright 2021. The GTL Authors. All rights reserved.
+// https://github.com/modern-dev/gtl
+// Use of this source code is governed by the MIT
+// license that can be found in the LICENSE file.
+
+package cache
+
+import (
+	"time"
+)
+
+type (
+	// entry is a cache entry linked into one of the recency lists of the cache.
+	entry[K comparable, V any] struct {
+		key       K
+		value     V
+		expiresAt time.Time
+		freq      int
+		prev      *entry[K, V]
+		next      *entry[K, V]
+		owner     *list[K, V]
+	}
+
+	// list is an intrusive circular doubly-linked list of entries with a sentinel root.
+	// The caches do not use deque.Deque, which removes only at its ends, and unordered_set,
+	// which cannot point from a key to its place in the order: a hit moves the entry from the middle of the list,
+	// so the key index refers to the entry directly, and the entry is unlinked in constant time.
+	list[K comparable, V any] struct {
+		root entry[K, V]
+		len  int
+	}
+)
+
+func newList[K comparable, V any]() *list[K, V] {
+	l := &list[K, V]{}
+	l.root.next = &l.root
+	l.root.prev = &l.root
+
+	return l
+}
+
+func (l *list[K, V]) pushFront(e *entry[K, V]) {
+	e.prev = &l.root
+	e.next = l.root.next
+	e.prev.next = e
+	e.next.prev = e
+	e.owner = l
+	l.len++
+}
+
+func (l *list[K, V]) remove(e *entry[K, V]) {
+	e.prev.next = e.next
+	e.next.prev = e.prev
+	e.prev, e.next, e.owner = nil, nil, nil
+	l.len--
+}
+
+func (l *list[K, V]) moveToFront(e *entry[K, V]) {
+	l.remove(e)
+	l.pushFront(e)
+}
+
+// back returns the least recently pushed entry or nil if the list is empty.
+func (l *list[K, V]) back() *entry[K, V] {
+	if l.len == 0 {
+		return nil
+	}
+
+	return l.root.prev
+}
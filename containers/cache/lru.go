@@ -0,0 +1,156 @@
+// Copyright 2021. The GTL Authors. All rights reserved.
+// https://github.com/modern-dev/gtl
+// Use of this source code is governed by the MIT
+// license that can be found in the LICENSE file.
+
+package cache
+
+// LRU is a cache that evicts the least recently used entry when it runs out of capacity.
+// It is not safe for concurrent use, see Synchronized.
+type LRU[K comparable, V any] struct {
+	base[K, V]
+	items map[K]*entry[K, V]
+	ll    *list[K, V]
+}
+
+// NewLRU creates an empty LRU holding at most capacity entries.
+func NewLRU[K comparable, V any](capacity int) *LRU[K, V] {
+	return NewLRUWithOptions[K, V](capacity, Options[K, V]{})
+}
+
+// NewLRUWithOptions creates an empty LRU holding at most capacity entries.
+func NewLRUWithOptions[K comparable, V any](capacity int, opts Options[K, V]) *LRU[K, V] {
+	return &LRU[K, V]{
+		base:  newBase(capacity, opts),
+		items: make(map[K]*entry[K, V]),
+		ll:    newList[K, V](),
+	}
+}
+
+// Size returns the number of entries in the cache, including the expired ones that were not dropped yet.
+// Complexity - O(1).
+func (c *LRU[K, V]) Size() int {
+	return len(c.items)
+}
+
+// Get returns the value stored for the key and marks the entry as the most recently used.
+// Complexity - O(1).
+func (c *LRU[K, V]) Get(key K) (V, bool) {
+	e, ok := c.lookup(key)
+
+	if !ok {
+		var emptyVal V
+
+		return emptyVal, false
+	}
+
+	c.ll.moveToFront(e)
+
+	return e.value, true
+}
+
+// Peek returns the value stored for the key without marking the entry as used.
+// Complexity - O(1).
+func (c *LRU[K, V]) Peek(key K) (V, bool) {
+	e, ok := c.lookup(key)
+
+	if !ok {
+		var emptyVal V
+
+		return emptyVal, false
+	}
+
+	return e.value, true
+}
+
+// Contains checks if the cache holds an entry for the key without marking it as used.
+// Complexity - O(1).
+func (c *LRU[K, V]) Contains(key K) bool {
+	_, ok := c.lookup(key)
+
+	return ok
+}
+
+// Put stores the value for the key and marks the entry as the most recently used.
+// If the cache is full, the least recently used entry is evicted.
+// Complexity - O(1).
+func (c *LRU[K, V]) Put(key K, value V) {
+	if e, ok := c.items[key]; ok {
+		e.value, e.expiresAt = value, c.deadline()
+		c.ll.moveToFront(e)
+
+		return
+	}
+
+	if len(c.items) >= c.capacity {
+		victim := c.ll.back()
+
+		c.drop(victim)
+		c.evicted(victim)
+	}
+
+	e := &entry[K, V]{key: key, value: value, expiresAt: c.deadline()}
+	c.items[key] = e
+	c.ll.pushFront(e)
+}
+
+// Remove deletes the entry for the key. Returns true if the cache held the entry.
+// Complexity - O(1).
+func (c *LRU[K, V]) Remove(key K) bool {
+	e, ok := c.items[key]
+
+	if ok {
+		c.drop(e)
+	}
+
+	return ok
+}
+
+// RemoveExpired drops all expired entries and returns their number.
+// Complexity - O(n), where n is the number of entries in the cache.
+func (c *LRU[K, V]) RemoveExpired() int {
+	var expired []*entry[K, V]
+
+	for _, e := range c.items {
+		if c.expired(e) {
+			expired = append(expired, e)
+		}
+	}
+
+	for _, e := range expired {
+		c.drop(e)
+		c.evicted(e)
+	}
+
+	return len(expired)
+}
+
+// Clear removes all entries from the cache.
+// Complexity - O(1).
+func (c *LRU[K, V]) Clear() {
+	c.items = make(map[K]*entry[K, V])
+	c.ll = newList[K, V]()
+}
+
+// lookup returns the live entry for the key, dropping it if it expired.
+func (c *LRU[K, V]) lookup(key K) (*entry[K, V], bool) {
+	e, ok := c.items[key]
+
+	if !ok {
+		return nil, false
+	}
+
+	if c.expired(e) {
+		c.drop(e)
+		c.evicted(e)
+
+		return nil, false
+	}
+
+	return e, true
+}
+
+func (c *LRU[K, V]) drop(e *entry[K, V]) {
+	c.ll.remove(e)
+	delete(c.items, e.key)
+}
@@ -0,0 +1,119 @@
+// Copyright 2021. The GTL Authors. All rights reserved.
+// https://github.com/modern-dev/gtl
+// Use of this source code is governed by the MIT
+// license that can be found in the LICENSE file.
+
+package cache
+
+import (
+	"testing"
+	"time"
+)
+
+var _ Cache[int, int] = NewLRU[int, int](1)
+
+func TestLRU(t *testing.T) {
+	var evicted []int
+
+	c := NewLRUWithOptions[int, string](2, Options[int, string]{
+		OnEvict: func(key int, _ string) { evicted = append(evicted, key) },
+	})
+
+	c.Put(1, "one")
+	c.Put(2, "two")
+	c.Get(1)
+	c.Put(3, "three")
+
+	checkCache[int, string](c, map[int]string{1: "one", 3: "three"}, []int{2}, t)
+
+	c.Peek(1)
+	c.Put(3, "THREE")
+	c.Put(4, "four")
+
+	checkCache[int, string](c, map[int]string{3: "THREE", 4: "four"}, []int{1, 2}, t)
+	checkEvicted(evicted, []int{2, 1}, t)
+
+	if !c.Remove(3) || c.Remove(3) {
+		t.Errorf("Expected Remove() to succeed only once")
+	}
+
+	c.Clear()
+	checkCache[int, string](c, map[int]string{}, []int{4}, t)
+}
+
+func TestLRUTTL(t *testing.T) {
+	var (
+		evicted []int
+		clock   = &fakeClock{time.Unix(0, 0)}
+	)
+
+	c := NewLRUWithOptions[int, int](10, Options[int, int]{
+		OnEvict: func(key int, _ int) { evicted = append(evicted, key) },
+		TTL:     time.Minute,
+		Clock:   clock.Now,
+	})
+
+	c.Put(1, 1)
+	clock.Advance(30 * time.Second)
+	c.Put(2, 2)
+	clock.Advance(30 * time.Second)
+
+	checkCache[int, int](c, map[int]int{2: 2}, []int{1}, t)
+
+	c.Put(3, 3)
+	clock.Advance(45 * time.Second)
+
+	if removed := c.RemoveExpired(); removed != 1 {
+		t.Errorf("Expected RemoveExpired() to drop %d entries, got %d", 1, removed)
+	}
+
+	checkCache[int, int](c, map[int]int{3: 3}, []int{1, 2}, t)
+	checkEvicted(evicted, []int{1, 2}, t)
+}
+
+type fakeClock struct {
+	now time.Time
+}
+
+func (c *fakeClock) Now() time.Time {
+	return c.now
+}
+
+func (c *fakeClock) Advance(d time.Duration) {
+	c.now = c.now.Add(d)
+}
+
+func checkCache[K comparable, V comparable](c Cache[K, V], present map[K]V, absent []K, t *testing.T) {
+	t.Helper()
+
+	for key, want := range present {
+		if got, ok := c.Peek(key); !ok || got != want {
+			t.Errorf("Expected Peek(%v) to return (%v, %t), got (%v, %t)", key, want, true, got, ok)
+		}
+	}
+
+	for _, key := range absent {
+		if c.Contains(key) {
+			t.Errorf("Expected cache to not contain %v", key)
+		}
+	}
+
+	// expired entries are counted until a lookup drops them
+	if c.Size() != len(present) {
+		t.Errorf("Expected cache size %d, got %d", len(present), c.Size())
+	}
+}
+
+func checkEvicted[K comparable](got, want []K, t *testing.T) {
+	t.Helper()
+
+	if len(got) != len(want) {
+		t.Fatalf("Expected evicted keys %v, got %v", want, got)
+	}
+
+	for i := range want {
+		if got[i] != want[i] {
+			t.Fatalf("Expected evicted keys %v, got %v", want, got)
+		}
+	}
+}
@@ -0,0 +1,114 @@
+// Copyright 2021. The GTL Authors. All rights reserved.
+// https://github.com/modern-dev/gtl
+// Use of this source code is governed by the MIT
+// license that can be found in the LICENSE file.
+
+package cache
+
+import (
+	"sync"
+)
+
+// Synchronized is a Cache wrapper that is safe for concurrent use.
+// Every call, including Get, updates the eviction state of the underlying cache,
+// so all of them are serialized with a single mutex.
+// The eviction callback runs with the mutex held and must not call the cache.
+type Synchronized[K comparable, V any] struct {
+	mu    sync.Mutex
+	cache Cache[K, V]
+}
+
+// NewSynchronized wraps the given cache.
+// The wrapped cache must not be used directly afterwards.
+func NewSynchronized[K comparable, V any](cache Cache[K, V]) *Synchronized[K, V] {
+	return &Synchronized[K, V]{cache: cache}
+}
+
+// Get returns the value stored for the key, see Cache.
+func (s *Synchronized[K, V]) Get(key K) (V, bool) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	return s.cache.Get(key)
+}
+
+// Peek returns the value stored for the key without marking the entry as used, see Cache.
+func (s *Synchronized[K, V]) Peek(key K) (V, bool) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	return s.cache.Peek(key)
+}
+
+// Contains checks if the cache holds an entry for the key, see Cache.
+func (s *Synchronized[K, V]) Contains(key K) bool {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	return s.cache.Contains(key)
+}
+
+// Put stores the value for the key, see Cache.
+func (s *Synchronized[K, V]) Put(key K, value V) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	s.cache.Put(key, value)
+}
+
+// GetOrPut returns the value stored for the key.
+// If there is no such value, the one returned by compute is stored and returned.
+// The check and the store happen atomically, compute runs with the mutex held and must not call the cache.
+func (s *Synchronized[K, V]) GetOrPut(key K, compute func() V) V {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	if value, ok := s.cache.Get(key); ok {
+		return value
+	}
+
+	value := compute()
+	s.cache.Put(key, value)
+
+	return value
+}
+
+// Remove deletes the entry for the key, see Cache.
+func (s *Synchronized[K, V]) Remove(key K) bool {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	return s.cache.Remove(key)
+}
+
+// RemoveExpired drops all expired entries and returns their number, see Cache.
+func (s *Synchronized[K, V]) RemoveExpired() int {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	return s.cache.RemoveExpired()
+}
+
+// Size returns the number of entries in the cache, see Cache.
+func (s *Synchronized[K, V]) Size() int {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	return s.cache.Size()
+}
+
+// Capacity returns the maximum number of entries in the cache, see Cache.
+func (s *Synchronized[K, V]) Capacity() int {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	return s.cache.Capacity()
+}
+
+// Clear removes all entries from the cache, see Cache.
+func (s *Synchronized[K, V]) Clear() {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	s.cache.Clear()
+}
@@ -0,0 +1,95 @@
+// Copyright 2021. The GTL Authors. All rights reserved.
+// https://github.com/modern-dev/gtl
+// Use of this source code is governed by the MIT
+// license that can be found in the LICENSE file.
+
+package cache
+
+import (
+	"sort"
+	"sync"
+	"testing"
+	"time"
+)
+
+var _ Cache[int, int] = NewSynchronized[int, int](NewLRU[int, int](1))
+
+func TestSynchronized(t *testing.T) {
+	const goroutines = 8
+
+	caches := map[string]Cache[int, int]{
+		"LRU": NewLRU[int, int](100),
+		"LFU": NewLFU[int, int](100),
+		"ARC": NewARC[int, int](100),
+	}
+
+	for name, cache := range caches {
+		t.Run(name, func(t *testing.T) {
+			s := NewSynchronized(cache)
+
+			var wg sync.WaitGroup
+
+			for g := 0; g < goroutines; g++ {
+				wg.Add(1)
+
+				go func(g int) {
+					defer wg.Done()
+
+					for i := 0; i < 1000; i++ {
+						key := (g*31 + i) % 150
+
+						if got := s.GetOrPut(key, func() int { return key * key }); got != key*key {
+							t.Errorf("Expected GetOrPut(%d) to return %d, got %d", key, key*key, got)
+						}
+					}
+				}(g)
+			}
+
+			wg.Wait()
+
+			if s.Size() != s.Capacity() {
+				t.Errorf("Expected cache to be full, got size %d", s.Size())
+			}
+		})
+	}
+}
+
+func TestSynchronizedRemoveExpired(t *testing.T) {
+	clock := &fakeClock{time.Unix(0, 0)}
+	constructors := map[string]func(opts Options[int, int]) Cache[int, int]{
+		"LRU": func(opts Options[int, int]) Cache[int, int] { return NewLRUWithOptions(10, opts) },
+		"LFU": func(opts Options[int, int]) Cache[int, int] { return NewLFUWithOptions(10, opts) },
+		"ARC": func(opts Options[int, int]) Cache[int, int] { return NewARCWithOptions(10, opts) },
+	}
+
+	for name, newCache := range constructors {
+		t.Run(name, func(t *testing.T) {
+			var evicted []int
+
+			s := NewSynchronized(newCache(Options[int, int]{
+				OnEvict: func(key int, _ int) { evicted = append(evicted, key) },
+				TTL:     time.Minute,
+				Clock:   clock.Now,
+			}))
+
+			s.Put(1, 1)
+			s.Put(2, 2)
+			clock.Advance(30 * time.Second)
+			s.Put(3, 3)
+			clock.Advance(30 * time.Second)
+
+			// the expired entries are dropped without touching their keys
+			if removed := s.RemoveExpired(); removed != 2 {
+				t.Errorf("Expected RemoveExpired() to drop %d entries, got %d", 2, removed)
+			}
+
+			if s.Size() != 1 || !s.Contains(3) {
+				t.Errorf("Expected only key %d to remain, got size %d", 3, s.Size())
+			}
+
+			// the policies drop expired entries in different orders
+			sort.Ints(evicted)
+			checkEvicted(evicted, []int{1, 2}, t)
+		})
+	}
+}
@@ -0,0 +1,253 @@
+// Copyright 2021. The GTL Authors. All rights reserved.
+// https://github.com/modern-dev/gtl
+// Use of this source code is governed by the MIT
+// license that can be found in the LICENSE file.
+
+package concurrent
+
+import (
+	"sync"
+	"sync/atomic"
+
+	"github.com/modern-dev/gtl/internal/hashing"
+	"github.com/modern-dev/gtl/utility"
+)
+
+// DefaultShards is the number of shards used by NewConcurrentMap and NewConcurrentSet.
+const DefaultShards = 32
+
+type (
+	// ConcurrentMap is a map that is safe for concurrent use.
+	// Keys are spread over lock-striped shards, so operations on different shards do not contend,
+	// which makes it faster than sync.Map for write-heavy loads.
+	ConcurrentMap[K comparable, V any] struct {
+		// size is the first field to keep it 64-bit aligned for the atomic operations
+		size   int64
+		shards []shard[K, V]
+		mask   uint64
+		hash   func(key K) uint64
+	}
+
+	shard[K comparable, V any] struct {
+		sync.RWMutex
+		table map[K]V
+	}
+)
+
+// NewConcurrentMap creates an empty ConcurrentMap with DefaultShards shards.
+func NewConcurrentMap[K comparable, V any]() *ConcurrentMap[K, V] {
+	return NewConcurrentMapWithShards[K, V](DefaultShards)
+}
+
+// NewConcurrentMapWithShards creates an empty ConcurrentMap with at least the given number of shards.
+// The number is rounded up to a power of two. Panics if it is not positive.
+func NewConcurrentMapWithShards[K comparable, V any](shards int) *ConcurrentMap[K, V] {
+	return newConcurrentMap[K, V](shards, hashKey[K])
+}
+
+// NewConcurrentMapWithHasher creates an empty ConcurrentMap with DefaultShards shards,
+// which picks the shard for a key with the given hasher instead of the generic hash.
+// Keys are still compared with ==, so the hasher must be consistent with it, e.g. utility.StringHasher.
+func NewConcurrentMapWithHasher[K comparable, V any](hasher utility.Hasher[K]) *ConcurrentMap[K, V] {
+	return NewConcurrentMapWithHasherAndShards[K, V](hasher, DefaultShards)
+}
+
+// NewConcurrentMapWithHasherAndShards creates an empty ConcurrentMap with at least the given number of shards,
+// which picks the shard for a key with the given hasher, see NewConcurrentMapWithHasher.
+// The number is rounded up to a power of two. Panics if it is not positive.
+func NewConcurrentMapWithHasherAndShards[K comparable, V any](hasher utility.Hasher[K], shards int) *ConcurrentMap[K, V] {
+	return newConcurrentMap[K, V](shards, hasher.Hash)
+}
+
+func newConcurrentMap[K comparable, V any](shards int, hash func(key K) uint64) *ConcurrentMap[K, V] {
+	if shards <= 0 {
+		panic("concurrent: number of shards must be positive")
+	}
+
+	n := 1
+
+	for n < shards {
+		n *= 2
+	}
+
+	m := &ConcurrentMap[K, V]{
+		shards: make([]shard[K, V], n),
+		mask:   uint64(n - 1),
+		hash:   hash,
+	}
+
+	for i := range m.shards {
+		m.shards[i].table = make(map[K]V)
+	}
+
+	return m
+}
+
+// Load returns the value stored for the key.
+// Returns the zero value of V and false if there is no such key.
+// Complexity - O(1).
+func (m *ConcurrentMap[K, V]) Load(key K) (V, bool) {
+	s := m.shard(key)
+	s.RLock()
+	value, ok := s.table[key]
+	s.RUnlock()
+
+	return value, ok
+}
+
+// Store sets the value for the key.
+// Complexity - O(1).
+func (m *ConcurrentMap[K, V]) Store(key K, value V) {
+	s := m.shard(key)
+	s.Lock()
+	defer s.Unlock()
+
+	if _, ok := s.table[key]; !ok {
+		atomic.AddInt64(&m.size, 1)
+	}
+
+	s.table[key] = value
+}
+
+// LoadOrStore returns the existing value for the key if present.
+// Otherwise, it stores and returns the given value. The loaded result is true if the value was loaded.
+// Complexity - O(1).
+func (m *ConcurrentMap[K, V]) LoadOrStore(key K, value V) (V, bool) {
+	s := m.shard(key)
+	s.Lock()
+	defer s.Unlock()
+
+	if actual, ok := s.table[key]; ok {
+		return actual, true
+	}
+
+	s.table[key] = value
+	atomic.AddInt64(&m.size, 1)
+
+	return value, false
+}
+
+// LoadAndDelete deletes the value for the key, returning the previous value if any.
+// Complexity - O(1).
+func (m *ConcurrentMap[K, V]) LoadAndDelete(key K) (V, bool) {
+	s := m.shard(key)
+	s.Lock()
+	defer s.Unlock()
+
+	value, ok := s.table[key]
+
+	if ok {
+		delete(s.table, key)
+		atomic.AddInt64(&m.size, -1)
+	}
+
+	return value, ok
+}
+
+// Delete deletes the value for the key.
+// Complexity - O(1).
+func (m *ConcurrentMap[K, V]) Delete(key K) {
+	m.LoadAndDelete(key)
+}
+
+// Compute atomically replaces the value for the key with the result of fn.
+// fn receives the current value and whether it is present, and returns the new value and whether to keep it;
+// the entry is deleted if keep is false. Returns the new value and keep.
+// fn is called under the lock of the shard, so it must not access the map.
+// Complexity - O(1) plus the complexity of fn.
+func (m *ConcurrentMap[K, V]) Compute(key K, fn func(old V, loaded bool) (value V, keep bool)) (V, bool) {
+	s := m.shard(key)
+	s.Lock()
+	defer s.Unlock()
+
+	old, loaded := s.table[key]
+	value, keep := fn(old, loaded)
+
+	switch {
+	case keep:
+		if !loaded {
+			atomic.AddInt64(&m.size, 1)
+		}
+
+		s.table[key] = value
+	case loaded:
+		delete(s.table, key)
+		atomic.AddInt64(&m.size, -1)
+	}
+
+	return value, keep
+}
+
+// Update atomically replaces the value for the key with the result of fn if the key is present.
+// Returns the new value and true if the key was present.
+// fn is called under the lock of the shard, so it must not access the map.
+// Complexity - O(1) plus the complexity of fn.
+func (m *ConcurrentMap[K, V]) Update(key K, fn func(old V) V) (V, bool) {
+	s := m.shard(key)
+	s.Lock()
+	defer s.Unlock()
+
+	old, ok := s.table[key]
+
+	if !ok {
+		return old, false
+	}
+
+	value := fn(old)
+	s.table[key] = value
+
+	return value, true
+}
+
+// Range calls fn for every entry until fn returns false.
+// The entries of a shard are copied under its lock before fn is called, so fn may access the map.
+// Like sync.Map.Range, it does not correspond to a consistent snapshot of the whole map.
+// Complexity - O(n), where n is the number of entries.
+func (m *ConcurrentMap[K, V]) Range(fn func(key K, value V) bool) {
+	var (
+		keys   []K
+		values []V
+	)
+
+	for i := range m.shards {
+		s := &m.shards[i]
+		keys, values = keys[:0], values[:0]
+
+		s.RLock()
+		for key, value := range s.table {
+			keys = append(keys, key)
+			values = append(values, value)
+		}
+		s.RUnlock()
+
+		for j := range keys {
+			if !fn(keys[j], values[j]) {
+				return
+			}
+		}
+	}
+}
+
+// Len returns the number of entries.
+// It is maintained atomically by every modification, so it never observes a torn state of the shards.
+// Complexity - O(1).
+func (m *ConcurrentMap[K, V]) Len() int {
+	return int(atomic.LoadInt64(&m.size))
+}
+
+// Clear removes all entries.
+// Complexity - O(n), where n is the number of entries.
+func (m *ConcurrentMap[K, V]) Clear() {
+	for i := range m.shards {
+		s := &m.shards[i]
+
+		s.Lock()
+		atomic.AddInt64(&m.size, -int64(len(s.table)))
+		s.table = make(map[K]V)
+		s.Unlock()
+	}
+}
+
+func (m *ConcurrentMap[K, V]) shard(key K) *shard[K, V] {
+	return &m.shards[hashing.Mix(m.hash(key))&m.mask]
+}
@@ -0,0 +1,192 @@
+// Copyright 2021. The GTL Authors. All rights reserved.
+// https://github.com/modern-dev/gtl
+// Use of this source code is governed by the MIT
+// license that can be found in the LICENSE file.
+
+package concurrent
+
+import (
+	"sort"
+	"sync"
+	"testing"
+
+	"github.com/modern-dev/gtl/utility"
+)
+
+func TestConcurrentMap(t *testing.T) {
+	m := NewConcurrentMapWithShards[string, int](3)
+
+	if len(m.shards) != 4 {
+		t.Errorf("Expected %d shards, got %d", 4, len(m.shards))
+	}
+
+	m.Store("a", 1)
+	m.Store("a", 2)
+
+	if value, loaded := m.LoadOrStore("a", 3); !loaded || value != 2 {
+		t.Errorf("Expected to get (%d, %t), got (%d, %t)", 2, true, value, loaded)
+	}
+
+	if value, loaded := m.LoadOrStore("b", 3); loaded || value != 3 {
+		t.Errorf("Expected to get (%d, %t), got (%d, %t)", 3, false, value, loaded)
+	}
+
+	if value, ok := m.Update("b", func(old int) int { return old * 10 }); !ok || value != 30 {
+		t.Errorf("Expected to get (%d, %t), got (%d, %t)", 30, true, value, ok)
+	}
+
+	if _, ok := m.Update("c", func(old int) int { return old + 1 }); ok || m.Len() != 2 {
+		t.Errorf("Expected Update() of a missing key to have no effect")
+	}
+
+	// Compute deletes the entry when asked not to keep it
+	m.Compute("a", func(old int, loaded bool) (int, bool) { return 0, false })
+
+	if value, ok := m.Load("a"); ok || m.Len() != 1 {
+		t.Errorf("Expected to get (%d, %t), got (%d, %t)", 0, false, value, ok)
+	}
+
+	if value, ok := m.LoadAndDelete("b"); !ok || value != 30 || m.Len() != 0 {
+		t.Errorf("Expected to get (%d, %t), got (%d, %t)", 30, true, value, ok)
+	}
+}
+
+func TestConcurrentMapContention(t *testing.T) {
+	const (
+		goroutines = 16
+		keys       = 100
+		increments = 1000
+	)
+
+	m := NewConcurrentMap[int, int]()
+
+	var wg sync.WaitGroup
+
+	for g := 0; g < goroutines; g++ {
+		wg.Add(1)
+
+		go func(g int) {
+			defer wg.Done()
+
+			for i := 0; i < increments; i++ {
+				key := (g + i) % keys
+
+				m.Compute(key, func(old int, _ bool) (int, bool) { return old + 1, true })
+
+				// churn a private key of the goroutine to exercise Len under contention
+				m.Store(-g-1, i)
+				m.Delete(-g - 1)
+			}
+		}(g)
+	}
+
+	wg.Wait()
+
+	if m.Len() != keys {
+		t.Errorf("Expected %d entries, got %d", keys, m.Len())
+	}
+
+	total, visited := 0, 0
+
+	m.Range(func(_ int, value int) bool {
+		total += value
+		visited++
+
+		// Range allows to access the map from the callback
+		m.Load(0)
+
+		return true
+	})
+
+	if total != goroutines*increments || visited != keys {
+		t.Errorf("Expected %d increments over %d keys, got %d over %d", goroutines*increments, keys, total, visited)
+	}
+
+	m.Clear()
+
+	if m.Len() != 0 {
+		t.Errorf("Expected map to be empty after Clear(), got %d entries", m.Len())
+	}
+}
+
+func TestConcurrentSet(t *testing.T) {
+	s := NewConcurrentSet[string]()
+
+	var (
+		wg       sync.WaitGroup
+		inserted int64
+		mu       sync.Mutex
+	)
+
+	for g := 0; g < 8; g++ {
+		wg.Add(1)
+
+		go func() {
+			defer wg.Done()
+
+			for _, word := range []string{"a", "b", "c", "d"} {
+				if s.TryInsert(word) {
+					mu.Lock()
+					inserted++
+					mu.Unlock()
+				}
+			}
+		}()
+	}
+
+	wg.Wait()
+
+	if inserted != 4 || s.Size() != 4 {
+		t.Errorf("Expected each of %d elements to be inserted once, got %d insertions and size %d", 4, inserted, s.Size())
+	}
+
+	s.Erase("a")
+	s.Insert("b")
+
+	if s.Contains("a") || !s.Contains("b") || s.Size() != 3 || s.Empty() {
+		t.Errorf("Expected set to contain [b c d]")
+	}
+
+	var items []string
+
+	s.Each(func(item string) bool {
+		items = append(items, item)
+
+		return true
+	})
+
+	sort.Strings(items)
+
+	if len(items) != 3 || items[0] != "b" || items[2] != "d" {
+		t.Errorf("Expected to get [b c d], got %v", items)
+	}
+}
+
+func TestConcurrentMapWithHasher(t *testing.T) {
+	calls := 0
+	constant := utility.MakeHasher(func(key int) uint64 {
+		calls++
+
+		return 42
+	}, func(lhs, rhs int) bool {
+		return lhs == rhs
+	})
+
+	m := NewConcurrentMapWithHasherAndShards[int, string](constant, 4)
+
+	for i := 0; i < 8; i++ {
+		m.Store(i, "v")
+	}
+
+	// the hasher picks the shard, so all keys end up in the same one
+	if shard := m.shard(0); len(shard.table) != 8 || calls != 9 {
+		t.Errorf("Expected %d keys in the shard and %d hasher calls, got %d and %d", 8, 9, len(shard.table), calls)
+	}
+
+	s := NewConcurrentSetWithHasher(utility.StringHasher())
+	s.Insert("a")
+
+	if !s.Contains("a") || s.Contains("b") {
+		t.Errorf("Expected set to contain only %q", "a")
+	}
+}
@@ -0,0 +1,91 @@
+// Copyright 2021. The GTL Authors. All rights reserved.
+// https://github.com/modern-dev/gtl
+// Use of this source code is governed by the MIT
+// license that can be found in the LICENSE file.
+
+package concurrent
+
+import (
+	"github.com/modern-dev/gtl/utility"
+)
+
+// ConcurrentSet is a set that is safe for concurrent use, based on ConcurrentMap.
+// It has the same method names as unordered_set.UnorderedSet.
+type ConcurrentSet[T comparable] struct {
+	table *ConcurrentMap[T, struct{}]
+}
+
+// NewConcurrentSet creates an empty ConcurrentSet with DefaultShards shards.
+func NewConcurrentSet[T comparable]() *ConcurrentSet[T] {
+	return NewConcurrentSetWithShards[T](DefaultShards)
+}
+
+// NewConcurrentSetWithShards creates an empty ConcurrentSet with at least the given number of shards.
+// The number is rounded up to a power of two. Panics if it is not positive.
+func NewConcurrentSetWithShards[T comparable](shards int) *ConcurrentSet[T] {
+	return &ConcurrentSet[T]{
+		NewConcurrentMapWithShards[T, struct{}](shards),
+	}
+}
+
+// NewConcurrentSetWithHasher creates an empty ConcurrentSet with DefaultShards shards,
+// which picks the shard for an element with the given hasher, see NewConcurrentMapWithHasher.
+func NewConcurrentSetWithHasher[T comparable](hasher utility.Hasher[T]) *ConcurrentSet[T] {
+	return &ConcurrentSet[T]{
+		NewConcurrentMapWithHasher[T, struct{}](hasher),
+	}
+}
+
+// Size returns the number of elements in the set.
+// Complexity - O(1).
+func (s *ConcurrentSet[T]) Size() int {
+	return s.table.Len()
+}
+
+// Empty checks if there are elements in the set.
+// Complexity - O(1).
+func (s *ConcurrentSet[T]) Empty() bool {
+	return s.Size() == 0
+}
+
+// Insert inserts element into the set.
+// Complexity - O(1).
+func (s *ConcurrentSet[T]) Insert(item T) {
+	s.table.Store(item, struct{}{})
+}
+
+// TryInsert inserts element into the set. Returns false if the set already contained the element.
+// Complexity - O(1).
+func (s *ConcurrentSet[T]) TryInsert(item T) bool {
+	_, loaded := s.table.LoadOrStore(item, struct{}{})
+
+	return !loaded
+}
+
+// Contains checks if the set contains given element.
+// Complexity - O(1).
+func (s *ConcurrentSet[T]) Contains(item T) bool {
+	_, ok := s.table.Load(item)
+
+	return ok
+}
+
+// Erase deletes the element from the set if it contains an element, does nothing otherwise.
+// Complexity - O(1).
+func (s *ConcurrentSet[T]) Erase(item T) {
+	s.table.Delete(item)
+}
+
+// Each calls fn for every element until fn returns false, see ConcurrentMap.Range.
+// Complexity - O(n), where n is the number of elements.
+func (s *ConcurrentSet[T]) Each(fn func(item T) bool) {
+	s.table.Range(func(item T, _ struct{}) bool {
+		return fn(item)
+	})
+}
+
+// Clear removes all elements from the set.
+// Complexity - O(n), where n is the number of elements.
+func (s *ConcurrentSet[T]) Clear() {
+	s.table.Clear()
+}
@@ -0,0 +1,96 @@
+// Copyright 2021. The GTL Authors. All rights reserved.
+// https://github.com/modern-dev/gtl
+// Use of this source code is governed by the MIT
+// license that can be found in the LICENSE file.
+
+package concurrent
+
+import (
+	"math"
+	"reflect"
+
+	"github.com/modern-dev/gtl/internal/hashing"
+	"github.com/modern-dev/gtl/utility"
+)
+
+// hashKey hashes any comparable value consistently with the == operator, so it can pick the shard for a key.
+// The common key types are hashed directly, the rest are walked with reflection.
+func hashKey[K comparable](key K) uint64 {
+	switch k := any(key).(type) {
+	case string:
+		return hashing.String(k)
+	case int:
+		return uint64(k)
+	case int64:
+		return uint64(k)
+	case int32:
+		return uint64(k)
+	case uint:
+		return uint64(k)
+	case uint64:
+		return k
+	case uint32:
+		return uint64(k)
+	}
+
+	return hashValue(reflect.ValueOf(&key).Elem())
+}
+
+func hashValue(v reflect.Value) uint64 {
+	switch v.Kind() {
+	case reflect.Bool:
+		if v.Bool() {
+			return 1
+		}
+
+		return 0
+	case reflect.Int, reflect.Int8, reflect.Int16, reflect.Int32, reflect.Int64:
+		return uint64(v.Int())
+	case reflect.Uint, reflect.Uint8, reflect.Uint16, reflect.Uint32, reflect.Uint64, reflect.Uintptr:
+		return v.Uint()
+	case reflect.Float32, reflect.Float64:
+		return hashFloat(v.Float())
+	case reflect.Complex64, reflect.Complex128:
+		c := v.Complex()
+
+		return utility.HashCombine(hashFloat(real(c)), hashFloat(imag(c)))
+	case reflect.String:
+		return hashing.String(v.String())
+	case reflect.Ptr, reflect.Chan, reflect.UnsafePointer:
+		return uint64(v.Pointer())
+	case reflect.Interface:
+		if v.IsNil() {
+			return 0
+		}
+
+		return hashValue(v.Elem())
+	case reflect.Array:
+		var hash uint64
+
+		for i := 0; i < v.Len(); i++ {
+			hash = utility.HashCombine(hash, hashValue(v.Index(i)))
+		}
+
+		return hash
+	case reflect.Struct:
+		var hash uint64
+
+		for i := 0; i < v.NumField(); i++ {
+			hash = utility.HashCombine(hash, hashValue(v.Field(i)))
+		}
+
+		return hash
+	}
+
+	// the remaining kinds are not comparable and cannot be keys
+	return 0
+}
+
+// hashFloat hashes a float so that +0 and -0 collide, as they are equal.
+func hashFloat(f float64) uint64 {
+	if f == 0 {
+		return 0
+	}
+
+	return math.Float64bits(f)
+}
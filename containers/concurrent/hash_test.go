@@ -0,0 +1,52 @@
+// Copyright 2021. The GTL Authors. All rights reserved.
+// https://github.com/modern-dev/gtl
+// Use of this source code is governed by the MIT
+// license that can be found in the LICENSE file.
+
+package concurrent
+
+import (
+	"math"
+	"reflect"
+	"testing"
+)
+
+type point struct {
+	x, y float64
+	tag  string
+}
+
+func TestHashKey(t *testing.T) {
+	negZero := math.Copysign(0, -1)
+	a, b := new(int), new(int)
+
+	assertSameHash(point{0, 1, "a"}, point{negZero, 1, "a"}, t)
+	assertSameHash([2]string{"a", "b"}, [2]string{"a", "b"}, t)
+	assertSameHash(a, a, t)
+
+	if hashKey(a) == hashKey(b) {
+		t.Errorf("Expected distinct pointers to have distinct hashes")
+	}
+
+	if hashKey(point{1, 2, ""}) == hashKey(point{2, 1, ""}) {
+		t.Errorf("Expected the order of the fields to affect the hash")
+	}
+
+	var lhs, rhs any = int8(3), int8(3)
+
+	if hashValue(reflect.ValueOf(&lhs).Elem()) != hashValue(reflect.ValueOf(&rhs).Elem()) {
+		t.Errorf("Expected equal interface values to have equal hashes")
+	}
+}
+
+func assertSameHash[K comparable](lhs, rhs K, t *testing.T) {
+	t.Helper()
+
+	if lhs != rhs {
+		t.Fatalf("Expected %v and %v to be equal", lhs, rhs)
+	}
+
+	if hashKey(lhs) != hashKey(rhs) {
+		t.Errorf("Expected equal keys %v and %v to have equal hashes", lhs, rhs)
+	}
+}
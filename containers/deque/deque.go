@@ -5,6 +5,12 @@
 
 package deque
 
+import (
+	"fmt"
+
+	"github.com/modern-dev/gtl/containers"
+)
+
 type (
 	// Deque basic generic deque (double-ended queue) implementation based on double-linked list
 	Deque[T any] struct {
@@ -74,9 +80,11 @@ func (d *Deque[T]) PushFront(element T) {
 }
 
 // PopBack returns and removes the last element from Deque.
-// Calling PopBack() on an empty Deque results in undefined behavior.
+// Calling PopBack() on an empty Deque panics with containers.ErrEmpty, see TryPopBack.
 // Complexity - O(1).
 func (d *Deque[T]) PopBack() T {
+	d.mustNotBeEmpty()
+
 	if d.tail == nil {
 		value := d.head.Value
 		d.reset()
@@ -90,9 +98,11 @@ func (d *Deque[T]) PopBack() T {
 }
 
 // PopFront returns and removes the first element from Deque.
-// Calling PopFront on an empty Deque results in undefined behavior.
+// Calling PopFront on an empty Deque panics with containers.ErrEmpty, see TryPopFront.
 // Complexity - O(1).
 func (d *Deque[T]) PopFront() T {
+	d.mustNotBeEmpty()
+
 	if d.head == nil {
 		value := d.tail.Value
 		d.reset()
@@ -106,9 +116,11 @@ func (d *Deque[T]) PopFront() T {
 }
 
 // Front returns values of the first element in Deque.
-// Calling Front on an empty Deque results in undefined behavior.
+// Calling Front on an empty Deque panics with containers.ErrEmpty, see TryFront.
 // Complexity - O(1).
 func (d *Deque[T]) Front() T {
+	d.mustNotBeEmpty()
+
 	if d.head == nil {
 		return d.tail.Value
 	}
@@ -117,9 +129,11 @@ func (d *Deque[T]) Front() T {
 }
 
 // Back returns values of the last element in Deque.
-// Calling Back on an empty Deque results in undefined behavior.
+// Calling Back on an empty Deque panics with containers.ErrEmpty, see TryBack.
 // Complexity - O(1).
 func (d *Deque[T]) Back() T {
+	d.mustNotBeEmpty()
+
 	if d.tail == nil {
 		return d.head.Value
 	}
@@ -127,6 +141,104 @@ func (d *Deque[T]) Back() T {
 	return d.tail.Value
 }
 
+// At returns value of the element at specified location pos, counting from the front of Deque.
+// If pos is not within the range of Deque, a panic is thrown.
+// Complexity - O(min(pos, n-pos)), where n is the number of elements in Deque.
+func (d *Deque[T]) At(pos int) T {
+	if pos < 0 || pos >= d.length {
+		panic(fmt.Sprintf("deque: index %d out of range [0, %d)", pos, d.length))
+	}
+
+	if pos < d.length/2 {
+		it := d.head
+
+		for ; pos > 0; pos-- {
+			it = it.Next
+		}
+
+		return it.Value
+	}
+
+	it := d.tail
+
+	for pos = d.length - 1 - pos; pos > 0; pos-- {
+		it = it.Prev
+	}
+
+	return it.Value
+}
+
+// Each calls fn for every element from front to back until fn returns false.
+// The Deque must not be modified during the iteration.
+// Complexity - O(n).
+func (d *Deque[T]) Each(fn func(T) bool) {
+	it := d.head
+
+	for i := 0; i < d.length; i, it = i+1, it.Next {
+		if !fn(it.Value) {
+			return
+		}
+	}
+}
+
+// TryPopBack returns and removes the last element from Deque.
+// Returns the zero value of T and false if the Deque is empty.
+// Complexity - O(1).
+func (d *Deque[T]) TryPopBack() (T, bool) {
+	if d.Empty() {
+		var emptyEl T
+
+		return emptyEl, false
+	}
+
+	return d.PopBack(), true
+}
+
+// TryPopFront returns and removes the first element from Deque.
+// Returns the zero value of T and false if the Deque is empty.
+// Complexity - O(1).
+func (d *Deque[T]) TryPopFront() (T, bool) {
+	if d.Empty() {
+		var emptyEl T
+
+		return emptyEl, false
+	}
+
+	return d.PopFront(), true
+}
+
+// TryFront returns value of the first element in Deque.
+// Returns the zero value of T and false if the Deque is empty.
+// Complexity - O(1).
+func (d *Deque[T]) TryFront() (T, bool) {
+	if d.Empty() {
+		var emptyEl T
+
+		return emptyEl, false
+	}
+
+	return d.Front(), true
+}
+
+// TryBack returns value of the last element in Deque.
+// Returns the zero value of T and false if the Deque is empty.
+// Complexity - O(1).
+func (d *Deque[T]) TryBack() (T, bool) {
+	if d.Empty() {
+		var emptyEl T
+
+		return emptyEl, false
+	}
+
+	return d.Back(), true
+}
+
+func (d *Deque[T]) mustNotBeEmpty() {
+	if d.Empty() {
+		panic(containers.ErrEmpty)
+	}
+}
+
 func (d *Deque[T]) insertIntoEmpty(node *node[T]) {
 	d.tail = node
 	d.head = node
@@ -6,7 +6,11 @@
 package deque
 
 import (
+	"reflect"
 	"testing"
+
+	"github.com/modern-dev/gtl/containers"
+	"github.com/modern-dev/gtl/funcs"
 )
 
 const enqueuesCount = 200
@@ -136,3 +140,130 @@ func checkDequeSize[T any](Deque *Deque[T], expected int, t *testing.T) {
 		t.Errorf("deque should have size %d but got %d", expected, Deque.Size())
 	}
 }
+
+func TestDequeEncoding(t *testing.T) {
+	d := NewDeque[int]()
+
+	for i := 0; i < enqueuesCount; i++ {
+		d.PushBack(i)
+	}
+
+	d.PopFront()
+	d.PopBack()
+
+	bin, err := d.MarshalBinary()
+	if err != nil {
+		t.Fatalf("MarshalBinary() failed: %v", err)
+	}
+
+	js, err := d.MarshalJSON()
+	if err != nil {
+		t.Fatalf("MarshalJSON() failed: %v", err)
+	}
+
+	fromBin, fromJSON := NewDeque[int](), NewDeque[int]()
+	fromBin.PushBack(42)
+
+	if err := fromBin.UnmarshalBinary(bin); err != nil {
+		t.Fatalf("UnmarshalBinary() failed: %v", err)
+	}
+
+	if err := fromJSON.UnmarshalJSON(js); err != nil {
+		t.Fatalf("UnmarshalJSON() failed: %v", err)
+	}
+
+	for _, restored := range []*Deque[int]{fromBin, fromJSON} {
+		checkDequeSize(restored, enqueuesCount-2, t)
+
+		for i := 1; i < enqueuesCount-1; i++ {
+			if el := restored.PopFront(); el != i {
+				t.Errorf("Expected to get %d, got %d", i, el)
+			}
+		}
+	}
+
+	if err := fromBin.UnmarshalBinary([]byte{binaryVersion + 1}); err == nil {
+		t.Errorf("Expected UnmarshalBinary() to reject unknown format version")
+	}
+}
+
+func TestDequeTryAccessors(t *testing.T) {
+	d := NewDeque[int]()
+
+	if _, ok := d.TryFront(); ok {
+		t.Errorf("Expected TryFront() on an empty deque to fail")
+	}
+
+	if _, ok := d.TryPopBack(); ok {
+		t.Errorf("Expected TryPopBack() on an empty deque to fail")
+	}
+
+	d.PushBack(1)
+	d.PushBack(2)
+
+	if el, ok := d.TryBack(); !ok || el != 2 {
+		t.Errorf("Expected to get (%d, %t), got (%d, %t)", 2, true, el, ok)
+	}
+
+	if el, ok := d.TryPopFront(); !ok || el != 1 {
+		t.Errorf("Expected to get (%d, %t), got (%d, %t)", 1, true, el, ok)
+	}
+
+	if el, ok := d.TryPopBack(); !ok || el != 2 {
+		t.Errorf("Expected to get (%d, %t), got (%d, %t)", 2, true, el, ok)
+	}
+
+	checkDequeSize(d, 0, t)
+
+	defer func() {
+		if r := recover(); r != containers.ErrEmpty {
+			t.Errorf("Expected PopFront() on an empty deque to panic with %v, got %v", containers.ErrEmpty, r)
+		}
+	}()
+
+	d.PopFront()
+}
+
+func TestDequeAt(t *testing.T) {
+	d := NewDeque[int]()
+
+	for i := 0; i < enqueuesCount; i++ {
+		d.PushBack(i)
+	}
+
+	d.PopFront()
+	d.PopBack()
+
+	for pos := 0; pos < d.Size(); pos++ {
+		if el := d.At(pos); el != pos+1 {
+			t.Errorf("Expected At(%d) to return %d, got %d", pos, pos+1, el)
+		}
+	}
+}
+
+func TestDequeEach(t *testing.T) {
+	d := NewDeque[int]()
+
+	if got := funcs.Collect(d.Each); got != nil {
+		t.Errorf("Expected no elements, got %v", got)
+	}
+
+	for i := 1; i <= 5; i++ {
+		d.PushBack(i)
+	}
+
+	d.PushFront(0)
+	d.PopBack()
+	d.PopFront()
+	d.PushBack(6)
+
+	if got, want := funcs.Collect(d.Each), []int{1, 2, 3, 4, 6}; !reflect.DeepEqual(got, want) {
+		t.Errorf("Expected to get %v, got %v", want, got)
+	}
+
+	even := funcs.FilterSeq(d.Each, func(n int) bool { return n%2 == 0 })
+
+	if got, want := funcs.Collect(even), []int{2, 4, 6}; !reflect.DeepEqual(got, want) {
+		t.Errorf("Expected to get %v, got %v", want, got)
+	}
+}
@@ -0,0 +1,77 @@
+// Copyright 2021. The GTL Authors. All rights reserved.
+// https://github.com/modern-dev/gtl
+// Use of this source code is governed by the MIT
+// license that can be found in the LICENSE file.
+
+package deque
+
+import (
+	"encoding/json"
+
+	"github.com/modern-dev/gtl/internal/serial"
+)
+
+// binaryVersion is the version of the format produced by MarshalBinary.
+const binaryVersion byte = 1
+
+// MarshalBinary implements the encoding.BinaryMarshaler interface.
+// The elements are encoded in order from front to back.
+func (d *Deque[T]) MarshalBinary() ([]byte, error) {
+	return serial.Marshal(binaryVersion, d.values())
+}
+
+// UnmarshalBinary implements the encoding.BinaryUnmarshaler interface.
+// The current contents of the Deque are replaced.
+func (d *Deque[T]) UnmarshalBinary(data []byte) error {
+	values, err := serial.Unmarshal[[]T](data, binaryVersion)
+
+	if err != nil {
+		return err
+	}
+
+	d.assign(values)
+
+	return nil
+}
+
+// MarshalJSON implements the json.Marshaler interface.
+// The Deque is encoded as a JSON array ordered from front to back.
+func (d *Deque[T]) MarshalJSON() ([]byte, error) {
+	return json.Marshal(d.values())
+}
+
+// UnmarshalJSON implements the json.Unmarshaler interface.
+// The current contents of the Deque are replaced.
+func (d *Deque[T]) UnmarshalJSON(data []byte) error {
+	var values []T
+
+	if err := json.Unmarshal(data, &values); err != nil {
+		return err
+	}
+
+	d.assign(values)
+
+	return nil
+}
+
+// values returns the elements of Deque ordered from front to back.
+func (d *Deque[T]) values() []T {
+	values := make([]T, 0, d.length)
+
+	d.Each(func(value T) bool {
+		values = append(values, value)
+
+		return true
+	})
+
+	return values
+}
+
+// assign replaces the contents of Deque with the given values.
+func (d *Deque[T]) assign(values []T) {
+	d.reset()
+
+	for _, value := range values {
+		d.PushBack(value)
+	}
+}
@@ -0,0 +1,30 @@
+// Copyright 2021. The GTL Authors. All rights reserved.
+// https://github.com/modern-dev/gtl
+// Use of this source code is governed by the MIT
+// license that can be found in the LICENSE file.
+
+// Package containers holds the definitions shared by all GTL containers.
+package containers
+
+import "errors"
+
+// ErrEmpty is the error of accessing an element of an empty container.
+// The accessors that used to have undefined behavior on an empty container panic with ErrEmpty,
+// while their Try counterparts report the empty container with a second boolean result.
+var ErrEmpty = errors.New("container is empty")
+
+// ErrFull is the error of inserting an element into a bounded container that reached its capacity.
+var ErrFull = errors.New("container is full")
+
+// ErrIfEmpty converts the result of a comma-ok accessor into an error-returning one:
+//
+//	value, err := containers.ErrIfEmpty(s.TryPop())
+//
+// Returns ErrEmpty if ok is false.
+func ErrIfEmpty[T any](value T, ok bool) (T, error) {
+	if !ok {
+		return value, ErrEmpty
+	}
+
+	return value, nil
+}
@@ -0,0 +1,18 @@
+// Copyright 2021. The GTL Authors. All rights reserved.
+// https://github.com/modern-dev/gtl
+// Use of this source code is governed by the MIT
+// license that can be found in the LICENSE file.
+
+package containers
+
+import "testing"
+
+func TestErrIfEmpty(t *testing.T) {
+	if value, err := ErrIfEmpty(42, true); value != 42 || err != nil {
+		t.Errorf("Expected to get (%d, %v), got (%d, %v)", 42, nil, value, err)
+	}
+
+	if value, err := ErrIfEmpty(0, false); value != 0 || err != ErrEmpty {
+		t.Errorf("Expected to get (%d, %v), got (%d, %v)", 0, ErrEmpty, value, err)
+	}
+}
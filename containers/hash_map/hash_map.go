@@ -0,0 +1,195 @@
+// Copyright 2021. The GTL Authors. All rights reserved.
+// https://github.com/modern-dev/gtl
+// Use of this source code is governed by the MIT
+// license that can be found in the LICENSE file.
+
+package hash_map
+
+import (
+	"github.com/modern-dev/gtl/internal/hashing"
+	"github.com/modern-dev/gtl/utility"
+)
+
+const (
+	minCapacity = 8
+	// the table grows when it is more than maxLoadNum/maxLoadDen full
+	maxLoadNum = 3
+	maxLoadDen = 4
+)
+
+type (
+	// HashMap is an unordered map with open addressing.
+	// Unlike a standard map it can hold keys of any type, their identity is defined by the provided utility.Hasher,
+	// so slices, structs holding slices or case-insensitive strings may be used as keys.
+	// Collisions are resolved with linear probing, the deleted slots are reclaimed with backward shifting,
+	// so the table does not degrade after many erasures.
+	HashMap[K any, V any] struct {
+		slots  []slot[K, V]
+		hasher utility.Hasher[K]
+		size   int
+	}
+
+	slot[K any, V any] struct {
+		key   K
+		value V
+		hash  uint64
+		used  bool
+	}
+)
+
+// NewHashMap creates an empty HashMap with provided hasher for keys.
+func NewHashMap[K any, V any](hasher utility.Hasher[K]) *HashMap[K, V] {
+	return NewHashMapWithCapacity[K, V](hasher, 0)
+}
+
+// NewHashMapWithCapacity creates an empty HashMap with provided hasher for keys
+// that can hold at least capacity entries without growing.
+func NewHashMapWithCapacity[K any, V any](hasher utility.Hasher[K], capacity int) *HashMap[K, V] {
+	m := &HashMap[K, V]{hasher: hasher}
+	m.slots = make([]slot[K, V], tableSize(capacity))
+
+	return m
+}
+
+// Size returns the number of entries in the container.
+// Complexity - O(1).
+func (m *HashMap[K, V]) Size() int {
+	return m.size
+}
+
+// Empty checks if there are entries in the container.
+// Complexity - O(1).
+func (m *HashMap[K, V]) Empty() bool {
+	return m.size == 0
+}
+
+// Insert stores the value for the key, replacing the previous one.
+// Complexity - amortized O(1).
+func (m *HashMap[K, V]) Insert(key K, value V) {
+	hash := hashing.Mix(m.hasher.Hash(key))
+
+	if i, ok := m.find(key, hash); ok {
+		m.slots[i].value = value
+
+		return
+	}
+
+	if (m.size+1)*maxLoadDen > len(m.slots)*maxLoadNum {
+		m.rehash(len(m.slots) * 2)
+	}
+
+	m.place(slot[K, V]{key: key, value: value, hash: hash, used: true})
+	m.size++
+}
+
+// Get returns the value stored for the key.
+// Returns the zero value of V and false if there is no such key.
+// Complexity - O(1) on average.
+func (m *HashMap[K, V]) Get(key K) (V, bool) {
+	if i, ok := m.find(key, hashing.Mix(m.hasher.Hash(key))); ok {
+		return m.slots[i].value, true
+	}
+
+	var emptyVal V
+
+	return emptyVal, false
+}
+
+// Contains checks if the container holds the key.
+// Complexity - O(1) on average.
+func (m *HashMap[K, V]) Contains(key K) bool {
+	_, ok := m.find(key, hashing.Mix(m.hasher.Hash(key)))
+
+	return ok
+}
+
+// Erase deletes the entry for the key if the container holds it, does nothing otherwise.
+// Complexity - O(1) on average.
+func (m *HashMap[K, V]) Erase(key K) {
+	i, ok := m.find(key, hashing.Mix(m.hasher.Hash(key)))
+
+	if !ok {
+		return
+	}
+
+	mask := len(m.slots) - 1
+
+	// shift back the following entries of the probe sequence that would become unreachable
+	for j := (i + 1) & mask; m.slots[j].used; j = (j + 1) & mask {
+		home := int(m.slots[j].hash) & mask
+
+		if (j-home)&mask >= (j-i)&mask {
+			m.slots[i] = m.slots[j]
+			i = j
+		}
+	}
+
+	m.slots[i] = slot[K, V]{}
+	m.size--
+}
+
+// Each calls fn for every entry until fn returns false.
+// The order of the iteration is not specified. The container must not be modified during the iteration.
+// Complexity - O(n), where n is the capacity of the table.
+func (m *HashMap[K, V]) Each(fn func(key K, value V) bool) {
+	for i := range m.slots {
+		if m.slots[i].used && !fn(m.slots[i].key, m.slots[i].value) {
+			return
+		}
+	}
+}
+
+// Clear removes all entries from the container keeping the allocated table.
+// Complexity - O(n), where n is the capacity of the table.
+func (m *HashMap[K, V]) Clear() {
+	for i := range m.slots {
+		m.slots[i] = slot[K, V]{}
+	}
+
+	m.size = 0
+}
+
+func (m *HashMap[K, V]) find(key K, hash uint64) (int, bool) {
+	mask := len(m.slots) - 1
+
+	for i := int(hash) & mask; m.slots[i].used; i = (i + 1) & mask {
+		if m.slots[i].hash == hash && m.hasher.Equal(m.slots[i].key, key) {
+			return i, true
+		}
+	}
+
+	return 0, false
+}
+
+func (m *HashMap[K, V]) place(s slot[K, V]) {
+	mask := len(m.slots) - 1
+	i := int(s.hash) & mask
+
+	for m.slots[i].used {
+		i = (i + 1) & mask
+	}
+
+	m.slots[i] = s
+}
+
+func (m *HashMap[K, V]) rehash(size int) {
+	old := m.slots
+	m.slots = make([]slot[K, V], size)
+
+	for i := range old {
+		if old[i].used {
+			m.place(old[i])
+		}
+	}
+}
+
+// tableSize returns the smallest power of two table that holds capacity entries within the load factor.
+func tableSize(capacity int) int {
+	size := minCapacity
+
+	for size*maxLoadNum < capacity*maxLoadDen {
+		size *= 2
+	}
+
+	return size
+}
@@ -0,0 +1,125 @@
+// Copyright 2021. The GTL Authors. All rights reserved.
+// https://github.com/modern-dev/gtl
+// Use of this source code is governed by the MIT
+// license that can be found in the LICENSE file.
+
+package hash_map
+
+import (
+	"math/rand"
+	"testing"
+
+	"github.com/modern-dev/gtl/utility"
+)
+
+func TestHashMapSliceKeys(t *testing.T) {
+	m := NewHashMap[[]int, string](utility.SliceHasher(utility.IntegerHasher[int]()))
+
+	m.Insert([]int{1, 2}, "a")
+	m.Insert([]int{2, 1}, "b")
+	m.Insert(nil, "empty")
+	m.Insert([]int{1, 2}, "c")
+
+	if m.Size() != 3 {
+		t.Errorf("Expected size %d, got %d", 3, m.Size())
+	}
+
+	if value, ok := m.Get([]int{1, 2}); !ok || value != "c" {
+		t.Errorf("Expected to get (%s, %t), got (%s, %t)", "c", true, value, ok)
+	}
+
+	if value, ok := m.Get([]int{}); !ok || value != "empty" {
+		t.Errorf("Expected to get (%s, %t), got (%s, %t)", "empty", true, value, ok)
+	}
+
+	m.Erase([]int{2, 1})
+	m.Erase([]int{3})
+
+	if m.Contains([]int{2, 1}) || m.Size() != 2 {
+		t.Errorf("Expected erased key to be absent")
+	}
+}
+
+func TestHashMapCaseInsensitive(t *testing.T) {
+	m := NewHashMap[string, int](utility.CaseInsensitiveHasher())
+
+	for _, word := range []string{"Go", "GO", "go", "gopher", "Gopher"} {
+		count, _ := m.Get(word)
+		m.Insert(word, count+1)
+	}
+
+	expected := map[string]int{"go": 3, "GOPHER": 2}
+
+	if m.Size() != len(expected) {
+		t.Errorf("Expected size %d, got %d", len(expected), m.Size())
+	}
+
+	for word, count := range expected {
+		if value, ok := m.Get(word); !ok || value != count {
+			t.Errorf("Expected to get (%d, %t), got (%d, %t)", count, true, value, ok)
+		}
+	}
+}
+
+func TestHashMapAgainstMap(t *testing.T) {
+	// a constant hash puts every key into a single probe sequence
+	hashers := map[string]utility.Hasher[int]{
+		"Integer":  utility.IntegerHasher[int](),
+		"Constant": utility.MakeHasher(func(int) uint64 { return 42 }, func(lhs, rhs int) bool { return lhs == rhs }),
+	}
+
+	for name, hasher := range hashers {
+		t.Run(name, func(t *testing.T) {
+			rnd := rand.New(rand.NewSource(7))
+			m := NewHashMap[int, int](hasher)
+			expected := make(map[int]int)
+
+			for i := 0; i < 5000; i++ {
+				key := rnd.Intn(300)
+
+				if rnd.Intn(3) == 0 {
+					m.Erase(key)
+					delete(expected, key)
+				} else {
+					m.Insert(key, i)
+					expected[key] = i
+				}
+			}
+
+			assertMap(m, expected, t)
+
+			m.Clear()
+			assertMap(m, map[int]int{}, t)
+		})
+	}
+}
+
+func assertMap(m *HashMap[int, int], expected map[int]int, t *testing.T) {
+	t.Helper()
+
+	if m.Size() != len(expected) || m.Empty() != (len(expected) == 0) {
+		t.Fatalf("Expected size %d, got %d", len(expected), m.Size())
+	}
+
+	for key, want := range expected {
+		if got, ok := m.Get(key); !ok || got != want {
+			t.Fatalf("Expected Get(%d) to return (%d, %t), got (%d, %t)", key, want, true, got, ok)
+		}
+	}
+
+	visited := 0
+
+	m.Each(func(key, value int) bool {
+		visited++
+
+		if expected[key] != value {
+			t.Errorf("Expected to iterate %d with %d, got %d", key, expected[key], value)
+		}
+
+		return true
+	})
+
+	if visited != len(expected) {
+		t.Errorf("Expected to iterate %d entries, got %d", len(expected), visited)
+	}
+}
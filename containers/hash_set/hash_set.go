@@ -0,0 +1,78 @@
+// Copyright 2021. The GTL Authors. All rights reserved.
+// https://github.com/modern-dev/gtl
+// Use of this source code is governed by the MIT
+// license that can be found in the LICENSE file.
+
+package hash_set
+
+import (
+	"github.com/modern-dev/gtl/containers/hash_map"
+	"github.com/modern-dev/gtl/utility"
+)
+
+// HashSet is an unordered set with open addressing based on hash_map.HashMap.
+// Unlike unordered_set.UnorderedSet it can hold elements of any type,
+// their identity is defined by the provided utility.Hasher.
+type HashSet[T any] struct {
+	table *hash_map.HashMap[T, struct{}]
+}
+
+// NewHashSet creates an empty HashSet with provided hasher for elements.
+func NewHashSet[T any](hasher utility.Hasher[T]) *HashSet[T] {
+	return NewHashSetWithCapacity[T](hasher, 0)
+}
+
+// NewHashSetWithCapacity creates an empty HashSet with provided hasher for elements
+// that can hold at least capacity elements without growing.
+func NewHashSetWithCapacity[T any](hasher utility.Hasher[T], capacity int) *HashSet[T] {
+	return &HashSet[T]{
+		hash_map.NewHashMapWithCapacity[T, struct{}](hasher, capacity),
+	}
+}
+
+// Size returns the number of elements in the container.
+// Complexity - O(1).
+func (s *HashSet[T]) Size() int {
+	return s.table.Size()
+}
+
+// Empty checks if there are elements in the set.
+// Complexity - O(1).
+func (s *HashSet[T]) Empty() bool {
+	return s.table.Empty()
+}
+
+// Insert inserts element into the set. Has no effect if an equal element is already there.
+// Complexity - amortized O(1).
+func (s *HashSet[T]) Insert(item T) {
+	if !s.table.Contains(item) {
+		s.table.Insert(item, struct{}{})
+	}
+}
+
+// Contains checks if the set contains given element.
+// Complexity - O(1) on average.
+func (s *HashSet[T]) Contains(item T) bool {
+	return s.table.Contains(item)
+}
+
+// Erase deletes the element from the set if it contains an element, does nothing otherwise.
+// Complexity - O(1) on average.
+func (s *HashSet[T]) Erase(item T) {
+	s.table.Erase(item)
+}
+
+// Each calls fn for every element until fn returns false.
+// The order of the iteration is not specified. The set must not be modified during the iteration.
+// Complexity - O(n), where n is the capacity of the table.
+func (s *HashSet[T]) Each(fn func(item T) bool) {
+	s.table.Each(func(item T, _ struct{}) bool {
+		return fn(item)
+	})
+}
+
+// Clear removes all elements from the set.
+// Complexity - O(n), where n is the capacity of the table.
+func (s *HashSet[T]) Clear() {
+	s.table.Clear()
+}
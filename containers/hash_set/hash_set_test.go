@@ -0,0 +1,49 @@
+// Copyright 2021. The GTL Authors. All rights reserved.
+// https://github.com/modern-dev/gtl
+// Use of this source code is governed by the MIT
+// license that can be found in the LICENSE file.
+
+package hash_set
+
+import (
+	"testing"
+
+	"github.com/modern-dev/gtl/utility"
+)
+
+func TestHashSet(t *testing.T) {
+	s := NewHashSet[[]byte](utility.BytesHasher())
+
+	for _, word := range []string{"alpha", "beta", "alpha", "gamma"} {
+		s.Insert([]byte(word))
+	}
+
+	if s.Size() != 3 || s.Empty() {
+		t.Errorf("Expected size %d, got %d", 3, s.Size())
+	}
+
+	if !s.Contains([]byte("beta")) || s.Contains([]byte("delta")) {
+		t.Errorf("Expected set to contain beta only")
+	}
+
+	s.Erase([]byte("beta"))
+	s.Erase([]byte("delta"))
+
+	var visited []string
+
+	s.Each(func(item []byte) bool {
+		visited = append(visited, string(item))
+
+		return true
+	})
+
+	if len(visited) != 2 || s.Contains([]byte("beta")) {
+		t.Errorf("Expected to iterate [alpha gamma] in any order, got %v", visited)
+	}
+
+	s.Clear()
+
+	if !s.Empty() {
+		t.Errorf("Expected set to be empty after Clear()")
+	}
+}
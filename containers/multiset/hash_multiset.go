@@ -0,0 +1,119 @@
+// Copyright 2021. The GTL Authors. All rights reserved.
+// https://github.com/modern-dev/gtl
+// Use of this source code is governed by the MIT
+// license that can be found in the LICENSE file.
+
+package multiset
+
+// HashMultiset is a multiset based on standard map.
+// It can contain comparable elements only and iterates them in no particular order.
+type HashMultiset[T comparable] struct {
+	counts map[T]int
+	size   int
+}
+
+// NewHashMultiset creates an empty HashMultiset.
+func NewHashMultiset[T comparable]() *HashMultiset[T] {
+	return &HashMultiset[T]{
+		counts: make(map[T]int),
+	}
+}
+
+// Add inserts n copies of the element. Panics if n is negative.
+// Complexity - O(1).
+func (m *HashMultiset[T]) Add(value T, n int) {
+	mustNotBeNegative(n)
+
+	if n == 0 {
+		return
+	}
+
+	m.counts[value] += n
+	m.size += n
+}
+
+// Remove deletes at most n copies of the element and returns the number of the deleted copies.
+// Panics if n is negative.
+// Complexity - O(1).
+func (m *HashMultiset[T]) Remove(value T, n int) int {
+	mustNotBeNegative(n)
+
+	count, ok := m.counts[value]
+
+	if !ok || n == 0 {
+		return 0
+	}
+
+	if n >= count {
+		delete(m.counts, value)
+		m.size -= count
+
+		return count
+	}
+
+	m.counts[value] = count - n
+	m.size -= n
+
+	return n
+}
+
+// RemoveAll deletes all copies of the element and returns their number.
+// Complexity - O(1).
+func (m *HashMultiset[T]) RemoveAll(value T) int {
+	count := m.counts[value]
+
+	delete(m.counts, value)
+	m.size -= count
+
+	return count
+}
+
+// Count returns the number of copies of the element.
+// Complexity - O(1).
+func (m *HashMultiset[T]) Count(value T) int {
+	return m.counts[value]
+}
+
+// Contains checks if there is at least one copy of the element.
+// Complexity - O(1).
+func (m *HashMultiset[T]) Contains(value T) bool {
+	_, ok := m.counts[value]
+
+	return ok
+}
+
+// Size returns the total number of copies of all elements.
+// Complexity - O(1).
+func (m *HashMultiset[T]) Size() int {
+	return m.size
+}
+
+// Distinct returns the number of distinct elements.
+// Complexity - O(1).
+func (m *HashMultiset[T]) Distinct() int {
+	return len(m.counts)
+}
+
+// Empty checks if there are no elements.
+// Complexity - O(1).
+func (m *HashMultiset[T]) Empty() bool {
+	return m.size == 0
+}
+
+// Each calls fn for every distinct element with its number of copies until fn returns false.
+// The order of the iteration is not specified. The multiset must not be modified during the iteration.
+// Complexity - O(n), where n is the number of distinct elements.
+func (m *HashMultiset[T]) Each(fn func(value T, count int) bool) {
+	for value, count := range m.counts {
+		if !fn(value, count) {
+			return
+		}
+	}
+}
+
+// Clear removes all elements.
+// Complexity - O(1).
+func (m *HashMultiset[T]) Clear() {
+	m.counts = make(map[T]int)
+	m.size = 0
+}
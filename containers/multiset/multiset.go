@@ -0,0 +1,36 @@
+// Copyright 2021. The GTL Authors. All rights reserved.
+// https://github.com/modern-dev/gtl
+// Use of this source code is governed by the MIT
+// license that can be found in the LICENSE file.
+
+package multiset
+
+// Multiset is a collection that keeps the number of copies of every distinct element, also known as a bag.
+type Multiset[T any] interface {
+	// Add inserts n copies of the element.
+	Add(value T, n int)
+	// Remove deletes at most n copies of the element and returns the number of the deleted copies.
+	Remove(value T, n int) int
+	// RemoveAll deletes all copies of the element and returns their number.
+	RemoveAll(value T) int
+	// Count returns the number of copies of the element.
+	Count(value T) int
+	// Contains checks if there is at least one copy of the element.
+	Contains(value T) bool
+	// Size returns the total number of copies of all elements.
+	Size() int
+	// Distinct returns the number of distinct elements.
+	Distinct() int
+	// Empty checks if there are no elements.
+	Empty() bool
+	// Each calls fn for every distinct element with its number of copies until fn returns false.
+	Each(fn func(value T, count int) bool)
+	// Clear removes all elements.
+	Clear()
+}
+
+func mustNotBeNegative(n int) {
+	if n < 0 {
+		panic("multiset: negative count")
+	}
+}
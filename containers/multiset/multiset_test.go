@@ -0,0 +1,148 @@
+// Copyright 2021. The GTL Authors. All rights reserved.
+// https://github.com/modern-dev/gtl
+// Use of this source code is governed by the MIT
+// license that can be found in the LICENSE file.
+
+package multiset
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/modern-dev/gtl/utility"
+)
+
+var (
+	_ Multiset[int] = NewHashMultiset[int]()
+	_ Multiset[int] = NewOrderedMultiset[int]()
+)
+
+func TestMultiset(t *testing.T) {
+	multisets := map[string]Multiset[string]{
+		"Hash":    NewHashMultiset[string](),
+		"Ordered": NewOrderedMultiset[string](),
+	}
+
+	for name, m := range multisets {
+		t.Run(name, func(t *testing.T) {
+			m.Add("a", 3)
+			m.Add("b", 1)
+			m.Add("a", 2)
+			m.Add("c", 0)
+
+			assertCounts(m, map[string]int{"a": 5, "b": 1}, t)
+
+			if removed := m.Remove("a", 2); removed != 2 {
+				t.Errorf("Expected to remove %d copies, got %d", 2, removed)
+			}
+
+			if removed := m.Remove("b", 10); removed != 1 {
+				t.Errorf("Expected to remove %d copies, got %d", 1, removed)
+			}
+
+			if removed := m.Remove("z", 1); removed != 0 {
+				t.Errorf("Expected to remove %d copies, got %d", 0, removed)
+			}
+
+			assertCounts(m, map[string]int{"a": 3}, t)
+
+			if m.Contains("b") || m.Count("b") != 0 {
+				t.Errorf("Expected removed element to be absent")
+			}
+
+			if removed := m.RemoveAll("a"); removed != 3 {
+				t.Errorf("Expected to remove %d copies, got %d", 3, removed)
+			}
+
+			assertCounts(m, map[string]int{}, t)
+
+			m.Add("x", 4)
+			m.Clear()
+			assertCounts(m, map[string]int{}, t)
+		})
+	}
+}
+
+func TestMultisetNegativeCount(t *testing.T) {
+	defer func() {
+		if recover() == nil {
+			t.Errorf("Expected Add() with a negative count to panic")
+		}
+	}()
+
+	NewHashMultiset[int]().Add(1, -1)
+}
+
+func TestOrderedMultiset(t *testing.T) {
+	m := NewOrderedMultisetWithOrdering[string](utility.CaseInsensitive())
+
+	for _, word := range strings.Fields("the Quick brown fox jumps over THE lazy dog The end") {
+		m.Add(word, 1)
+	}
+
+	if m.Count("THE") != 3 {
+		t.Errorf("Expected %d copies, got %d", 3, m.Count("THE"))
+	}
+
+	var words []string
+
+	m.Each(func(value string, count int) bool {
+		words = append(words, strings.ToLower(value))
+
+		return true
+	})
+
+	expected := []string{"brown", "dog", "end", "fox", "jumps", "lazy", "over", "quick", "the"}
+
+	if strings.Join(words, " ") != strings.Join(expected, " ") {
+		t.Errorf("Expected to iterate %v, got %v", expected, words)
+	}
+
+	if value, count := m.Min(); value != "brown" || count != 1 {
+		t.Errorf("Expected to get (%s, %d), got (%s, %d)", "brown", 1, value, count)
+	}
+
+	if value, count := m.Max(); strings.ToLower(value) != "the" || count != 3 {
+		t.Errorf("Expected to get (%s, %d), got (%s, %d)", "the", 3, value, count)
+	}
+
+	m.Clear()
+
+	if value, count := m.Max(); value != "" || count != 0 {
+		t.Errorf("Expected to get (%q, %d), got (%q, %d)", "", 0, value, count)
+	}
+}
+
+func assertCounts(m Multiset[string], expected map[string]int, t *testing.T) {
+	t.Helper()
+
+	size := 0
+
+	for value, count := range expected {
+		size += count
+
+		if m.Count(value) != count || !m.Contains(value) {
+			t.Errorf("Expected %d copies of %s, got %d", count, value, m.Count(value))
+		}
+	}
+
+	if m.Size() != size || m.Distinct() != len(expected) || m.Empty() != (size == 0) {
+		t.Errorf("Expected size %d with %d distinct elements, got %d with %d", size, len(expected), m.Size(), m.Distinct())
+	}
+
+	visited := 0
+
+	m.Each(func(value string, count int) bool {
+		visited++
+
+		if expected[value] != count {
+			t.Errorf("Expected to iterate %s with %d copies, got %d", value, expected[value], count)
+		}
+
+		return true
+	})
+
+	if visited != len(expected) {
+		t.Errorf("Expected to iterate %d elements, got %d", len(expected), visited)
+	}
+}
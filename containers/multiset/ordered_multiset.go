@@ -0,0 +1,192 @@
+// Copyright 2021. The GTL Authors. All rights reserved.
+// https://github.com/modern-dev/gtl
+// Use of this source code is governed by the MIT
+// license that can be found in the LICENSE file.
+
+package multiset
+
+import (
+	"constraints"
+
+	"github.com/modern-dev/gtl/containers/rbtree"
+	"github.com/modern-dev/gtl/utility"
+)
+
+type (
+	// OrderedMultiset is a multiset based on red-black tree.
+	// Every distinct element is stored once alongside with its number of copies,
+	// so unlike rbtree.RBTree with duplicates it counts and removes copies in O(log n).
+	// Elements are iterated in ascending order.
+	OrderedMultiset[T any] struct {
+		tree     *rbtree.RBTree[*bucket[T]]
+		ordering utility.Ordering[*bucket[T]]
+		size     int
+	}
+
+	bucket[T any] struct {
+		value T
+		count int
+	}
+)
+
+// NewOrderedMultiset creates an empty OrderedMultiset ordered by the natural order of the elements.
+func NewOrderedMultiset[T constraints.Ordered]() *OrderedMultiset[T] {
+	return NewOrderedMultisetWithOrdering[T](utility.Natural[T]())
+}
+
+// NewOrderedMultisetWithComparator creates an empty OrderedMultiset with provided comparator for elements.
+func NewOrderedMultisetWithComparator[T any](comparator utility.Compare[T]) *OrderedMultiset[T] {
+	return NewOrderedMultisetWithOrdering[T](utility.ToOrdering(comparator))
+}
+
+// NewOrderedMultisetWithOrdering creates an empty OrderedMultiset with provided three-way comparator for elements.
+// Two elements are considered copies of each other if the comparator returns zero for them.
+func NewOrderedMultisetWithOrdering[T any](ordering utility.Ordering[T]) *OrderedMultiset[T] {
+	byValue := func(lhs, rhs *bucket[T]) int {
+		return ordering(lhs.value, rhs.value)
+	}
+
+	return &OrderedMultiset[T]{
+		tree:     rbtree.NewRBTreeWithOrdering[*bucket[T]](byValue),
+		ordering: byValue,
+	}
+}
+
+// Add inserts n copies of the element. Panics if n is negative.
+// Complexity - O(log n), where n is the number of distinct elements.
+func (m *OrderedMultiset[T]) Add(value T, n int) {
+	mustNotBeNegative(n)
+
+	if n == 0 {
+		return
+	}
+
+	if b, ok := m.find(value); ok {
+		b.count += n
+	} else {
+		m.tree.Insert(&bucket[T]{value: value, count: n})
+	}
+
+	m.size += n
+}
+
+// Remove deletes at most n copies of the element and returns the number of the deleted copies.
+// Panics if n is negative.
+// Complexity - O(log n), where n is the number of distinct elements.
+func (m *OrderedMultiset[T]) Remove(value T, n int) int {
+	mustNotBeNegative(n)
+
+	b, ok := m.find(value)
+
+	if !ok || n == 0 {
+		return 0
+	}
+
+	if n >= b.count {
+		n = b.count
+		m.tree.Erase(b)
+	} else {
+		b.count -= n
+	}
+
+	m.size -= n
+
+	return n
+}
+
+// RemoveAll deletes all copies of the element and returns their number.
+// Complexity - O(log n), where n is the number of distinct elements.
+func (m *OrderedMultiset[T]) RemoveAll(value T) int {
+	b, ok := m.find(value)
+
+	if !ok {
+		return 0
+	}
+
+	m.tree.Erase(b)
+	m.size -= b.count
+
+	return b.count
+}
+
+// Count returns the number of copies of the element.
+// Complexity - O(log n), where n is the number of distinct elements.
+func (m *OrderedMultiset[T]) Count(value T) int {
+	if b, ok := m.find(value); ok {
+		return b.count
+	}
+
+	return 0
+}
+
+// Contains checks if there is at least one copy of the element.
+// Complexity - O(log n), where n is the number of distinct elements.
+func (m *OrderedMultiset[T]) Contains(value T) bool {
+	_, ok := m.find(value)
+
+	return ok
+}
+
+// Size returns the total number of copies of all elements.
+// Complexity - O(1).
+func (m *OrderedMultiset[T]) Size() int {
+	return m.size
+}
+
+// Distinct returns the number of distinct elements.
+// Complexity - O(1).
+func (m *OrderedMultiset[T]) Distinct() int {
+	return m.tree.Size()
+}
+
+// Empty checks if there are no elements.
+// Complexity - O(1).
+func (m *OrderedMultiset[T]) Empty() bool {
+	return m.size == 0
+}
+
+// Each calls fn for every distinct element with its number of copies in ascending order until fn returns false.
+// The multiset must not be modified during the iteration.
+// Complexity - O(n), where n is the number of distinct elements.
+func (m *OrderedMultiset[T]) Each(fn func(value T, count int) bool) {
+	m.tree.Each(func(b *bucket[T]) bool {
+		return fn(b.value, b.count)
+	})
+}
+
+// Min returns the least element and its number of copies.
+// Returns the zero value of T and zero if the multiset is empty.
+// Complexity - O(log n), where n is the number of distinct elements.
+func (m *OrderedMultiset[T]) Min() (T, int) {
+	if b, ok := m.tree.TryMin(); ok {
+		return b.value, b.count
+	}
+
+	var emptyEl T
+
+	return emptyEl, 0
+}
+
+// Max returns the greatest element and its number of copies.
+// Returns the zero value of T and zero if the multiset is empty.
+// Complexity - O(log n), where n is the number of distinct elements.
+func (m *OrderedMultiset[T]) Max() (T, int) {
+	if b, ok := m.tree.TryMax(); ok {
+		return b.value, b.count
+	}
+
+	var emptyEl T
+
+	return emptyEl, 0
+}
+
+// Clear removes all elements.
+// Complexity - O(1).
+func (m *OrderedMultiset[T]) Clear() {
+	m.tree = rbtree.NewRBTreeWithOrdering[*bucket[T]](m.ordering)
+	m.size = 0
+}
+
+func (m *OrderedMultiset[T]) find(value T) (*bucket[T], bool) {
+	return m.tree.Find(&bucket[T]{value: value})
+}
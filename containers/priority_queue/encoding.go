@@ -0,0 +1,79 @@
+// Copyright 2021. The GTL Authors. All rights reserved.
+// https://github.com/modern-dev/gtl
+// Use of this source code is governed by the MIT
+// license that can be found in the LICENSE file.
+
+package priority_queue
+
+import (
+	"encoding/json"
+	"errors"
+
+	"github.com/modern-dev/gtl/algo"
+	"github.com/modern-dev/gtl/internal/serial"
+)
+
+// binaryVersion is the version of the format produced by MarshalBinary.
+const binaryVersion byte = 1
+
+// ErrNoComparator is returned when decoding into a PriorityQueue that was not created by one of the constructors.
+// The comparator is not a part of the encoded data, so the queue has to be created before decoding.
+var ErrNoComparator = errors.New("priority_queue: comparator is not set")
+
+// MarshalBinary implements the encoding.BinaryMarshaler interface.
+// The elements are encoded in heap order.
+func (h *PriorityQueue[T]) MarshalBinary() ([]byte, error) {
+	return serial.Marshal(binaryVersion, h.values())
+}
+
+// UnmarshalBinary implements the encoding.BinaryUnmarshaler interface.
+// The current contents of the PriorityQueue are replaced and the heap is rebuilt using its comparator.
+func (h *PriorityQueue[T]) UnmarshalBinary(data []byte) error {
+	if h.cmpInst == nil {
+		return ErrNoComparator
+	}
+
+	values, err := serial.Unmarshal[[]T](data, binaryVersion)
+
+	if err != nil {
+		return err
+	}
+
+	h.assign(values)
+
+	return nil
+}
+
+// MarshalJSON implements the json.Marshaler interface.
+// The PriorityQueue is encoded as a JSON array in heap order.
+func (h *PriorityQueue[T]) MarshalJSON() ([]byte, error) {
+	return json.Marshal(h.values())
+}
+
+// UnmarshalJSON implements the json.Unmarshaler interface.
+// The current contents of the PriorityQueue are replaced and the heap is rebuilt using its comparator.
+func (h *PriorityQueue[T]) UnmarshalJSON(data []byte) error {
+	if h.cmpInst == nil {
+		return ErrNoComparator
+	}
+
+	var values []T
+
+	if err := json.Unmarshal(data, &values); err != nil {
+		return err
+	}
+
+	h.assign(values)
+
+	return nil
+}
+
+func (h *PriorityQueue[T]) values() []T {
+	return append([]T{}, h.heapList...)
+}
+
+func (h *PriorityQueue[T]) assign(values []T) {
+	h.heapList = append([]T{}, values...)
+
+	algo.MakeHeap(h.heapList, h.cmpInst)
+}
@@ -0,0 +1,175 @@
+// Copyright 2021. The GTL Authors. All rights reserved.
+// https://github.com/modern-dev/gtl
+// Use of this source code is governed by the MIT
+// license that can be found in the LICENSE file.
+
+package priority_queue
+
+import (
+	"constraints"
+
+	"github.com/modern-dev/gtl/containers"
+	"github.com/modern-dev/gtl/utility"
+)
+
+type (
+	// A LeftistHeap is a mergeable priority queue based on a leftist binary tree.
+	// It provides logarithmic insertion, extraction and melding in the worst case.
+	// Like PriorityQueue, the largest (by default) element appears as the Top().
+	LeftistHeap[T any] struct {
+		root    *leftistNode[T]
+		size    int
+		cmpInst utility.Compare[T]
+	}
+
+	// leftistNode is a node of the LeftistHeap.
+	// The rank is the length of the shortest path to a missing child, which is always along the right spine.
+	leftistNode[T any] struct {
+		value T
+		left  *leftistNode[T]
+		right *leftistNode[T]
+		rank  int
+	}
+)
+
+// NewLeftistHeap constructs the LeftistHeap.
+func NewLeftistHeap[T constraints.Ordered]() *LeftistHeap[T] {
+	return NewLeftistHeapWithComparator[T](&utility.Less[T]{})
+}
+
+// NewLeftistHeapWithComparator constructs the LeftistHeap.
+// A utility.Compare type providing a strict weak ordering.
+func NewLeftistHeapWithComparator[T any](comparator utility.Compare[T]) *LeftistHeap[T] {
+	return &LeftistHeap[T]{cmpInst: comparator}
+}
+
+// NewLeftistHeapWithComparatorFunc constructs the LeftistHeap.
+// A less function providing a strict weak ordering, see utility.CompareFunc.
+func NewLeftistHeapWithComparatorFunc[T any](less func(lhs, rhs T) bool) *LeftistHeap[T] {
+	return NewLeftistHeapWithComparator[T](utility.CompareFunc[T](less))
+}
+
+// NewLeftistHeapWithOrdering constructs the LeftistHeap.
+// A utility.Ordering three-way comparator providing a strict weak ordering.
+func NewLeftistHeapWithOrdering[T any](ordering utility.Ordering[T]) *LeftistHeap[T] {
+	return NewLeftistHeapWithComparator[T](utility.ToCompare(ordering))
+}
+
+// Size returns the number of elements in the LeftistHeap.
+// Complexity - constant.
+func (h *LeftistHeap[T]) Size() int {
+	return h.size
+}
+
+// Empty checks if the LeftistHeap has no elements
+// Complexity - constant.
+func (h *LeftistHeap[T]) Empty() bool {
+	return h.Size() == 0
+}
+
+// Push pushes the given element value to the LeftistHeap.
+// Complexity - logarithmic.
+func (h *LeftistHeap[T]) Push(value T) {
+	h.root = h.meld(h.root, &leftistNode[T]{value: value, rank: 1})
+	h.size++
+}
+
+// Pop removes the top element from the LeftistHeap.
+// Calling Pop on an empty LeftistHeap panics with containers.ErrEmpty, see TryPop.
+// Complexity - logarithmic.
+func (h *LeftistHeap[T]) Pop() T {
+	if h.Empty() {
+		panic(containers.ErrEmpty)
+	}
+
+	root := h.root
+
+	h.root = h.meld(root.left, root.right)
+	h.size--
+
+	return root.value
+}
+
+// Top returns reference to the top element in the LeftistHeap.
+// Calling Top on an empty LeftistHeap panics with containers.ErrEmpty, see TryTop.
+// Complexity - constant.
+func (h *LeftistHeap[T]) Top() T {
+	if h.Empty() {
+		panic(containers.ErrEmpty)
+	}
+
+	return h.root.value
+}
+
+// TryTop returns the top element in the LeftistHeap.
+// Returns the zero value of T and false if the LeftistHeap is empty.
+// Complexity - constant.
+func (h *LeftistHeap[T]) TryTop() (T, bool) {
+	if h.Empty() {
+		var emptyEl T
+
+		return emptyEl, false
+	}
+
+	return h.Top(), true
+}
+
+// TryPop removes the top element from the LeftistHeap.
+// Returns the zero value of T and false if the LeftistHeap is empty.
+// Complexity - the same as for Pop.
+func (h *LeftistHeap[T]) TryPop() (T, bool) {
+	if h.Empty() {
+		var emptyEl T
+
+		return emptyEl, false
+	}
+
+	return h.Pop(), true
+}
+
+// Merge moves all elements of other into the LeftistHeap, other is left empty.
+// Both heaps are expected to use the same ordering.
+// Complexity - logarithmic.
+func (h *LeftistHeap[T]) Merge(other *LeftistHeap[T]) {
+	if h == other {
+		return
+	}
+
+	h.root = h.meld(h.root, other.root)
+	h.size += other.size
+
+	other.root, other.size = nil, 0
+}
+
+// meld merges the right spines of two trees, swapping children where needed to keep the tree leftist.
+func (h *LeftistHeap[T]) meld(a, b *leftistNode[T]) *leftistNode[T] {
+	if a == nil {
+		return b
+	}
+
+	if b == nil {
+		return a
+	}
+
+	if h.cmpInst.Cmp(a.value, b.value) {
+		a, b = b, a
+	}
+
+	a.right = h.meld(a.right, b)
+
+	if leftistRank(a.left) < leftistRank(a.right) {
+		a.left, a.right = a.right, a.left
+	}
+
+	a.rank = leftistRank(a.right) + 1
+
+	return a
+}
+
+func leftistRank[T any](node *leftistNode[T]) int {
+	if node == nil {
+		return 0
+	}
+
+	return node.rank
+}
@@ -0,0 +1,65 @@
+// Copyright 2021. The GTL Authors. All rights reserved.
+// https://github.com/modern-dev/gtl
+// Use of this source code is governed by the MIT
+// license that can be found in the LICENSE file.
+
+package priority_queue
+
+import (
+	"math/rand"
+	"sort"
+	"testing"
+
+	"github.com/modern-dev/gtl/utility"
+)
+
+var _ Interface[int] = NewLeftistHeap[int]()
+
+func TestLeftistHeap(t *testing.T) {
+	h := NewLeftistHeap[int]()
+
+	for _, v := range []int{5, 6, 7, 9, 14, 11, 10} {
+		h.Push(v)
+	}
+
+	checkDrain[int](h, []int{14, 11, 10, 9, 7, 6, 5}, t)
+
+	if _, ok := h.TryTop(); ok {
+		t.Errorf("Expected TryTop() on an empty heap to fail")
+	}
+}
+
+func TestLeftistHeapRandom(t *testing.T) {
+	rnd := rand.New(rand.NewSource(42))
+	h := NewLeftistHeapWithComparator[int](&utility.Greater[int]{})
+	items := rnd.Perm(1000)
+
+	for _, v := range items {
+		h.Push(v)
+	}
+
+	sort.Ints(items)
+	checkDrain[int](h, items, t)
+}
+
+func TestLeftistHeapMerge(t *testing.T) {
+	// k-way merge of sorted shards
+	shards := [][]int{{1, 4, 7}, {2, 5, 8}, {3, 6, 9}, {}}
+	merged := NewLeftistHeapWithComparator[int](&utility.Greater[int]{})
+
+	for _, shard := range shards {
+		h := NewLeftistHeapWithComparator[int](&utility.Greater[int]{})
+
+		for _, v := range shard {
+			h.Push(v)
+		}
+
+		merged.Merge(h)
+
+		if !h.Empty() {
+			t.Errorf("Expected merged heap to be empty, got size {%d}", h.Size())
+		}
+	}
+
+	checkDrain[int](merged, []int{1, 2, 3, 4, 5, 6, 7, 8, 9}, t)
+}
@@ -0,0 +1,380 @@
+// Copyright 2021. The GTL Authors. All rights reserved.
+// https://github.com/modern-dev/gtl
+// Use of this source code is governed by the MIT
+// license that can be found in the LICENSE file.
+
+package priority_queue
+
+import (
+	"constraints"
+	"math/bits"
+
+	"github.com/modern-dev/gtl/containers"
+	"github.com/modern-dev/gtl/utility"
+)
+
+// A MinMaxHeap is a double-ended priority queue that provides constant time lookup of both the smallest
+// and the largest element, at the expense of logarithmic insertion and extraction.
+// The elements on even levels of the underlying tree are not greater than their descendants,
+// while the elements on odd levels are not less than their descendants.
+// A bounded MinMaxHeap drops its smallest element when an insertion exceeds the capacity.
+// Top and Pop operate on the largest element, so MinMaxHeap may be used wherever PriorityQueue is.
+type MinMaxHeap[T any] struct {
+	heapList []T
+	capacity int
+	cmpInst  utility.Compare[T]
+}
+
+// NewMinMaxHeap constructs the unbounded MinMaxHeap.
+func NewMinMaxHeap[T constraints.Ordered]() *MinMaxHeap[T] {
+	return NewBoundedMinMaxHeapWithComparator[T](0, &utility.Less[T]{})
+}
+
+// NewMinMaxHeapWithComparator constructs the unbounded MinMaxHeap.
+// A utility.Compare type providing a strict weak ordering.
+func NewMinMaxHeapWithComparator[T any](comparator utility.Compare[T]) *MinMaxHeap[T] {
+	return NewBoundedMinMaxHeapWithComparator[T](0, comparator)
+}
+
+// NewMinMaxHeapWithComparatorFunc constructs the unbounded MinMaxHeap.
+// A less function providing a strict weak ordering, see utility.CompareFunc.
+func NewMinMaxHeapWithComparatorFunc[T any](less func(lhs, rhs T) bool) *MinMaxHeap[T] {
+	return NewMinMaxHeapWithComparator[T](utility.CompareFunc[T](less))
+}
+
+// NewMinMaxHeapWithOrdering constructs the unbounded MinMaxHeap.
+// A utility.Ordering three-way comparator providing a strict weak ordering.
+func NewMinMaxHeapWithOrdering[T any](ordering utility.Ordering[T]) *MinMaxHeap[T] {
+	return NewMinMaxHeapWithComparator[T](utility.ToCompare(ordering))
+}
+
+// NewBoundedMinMaxHeap constructs the MinMaxHeap holding at most capacity elements.
+// A capacity of zero or less means that the heap is unbounded.
+func NewBoundedMinMaxHeap[T constraints.Ordered](capacity int) *MinMaxHeap[T] {
+	return NewBoundedMinMaxHeapWithComparator[T](capacity, &utility.Less[T]{})
+}
+
+// NewBoundedMinMaxHeapWithComparator constructs the MinMaxHeap holding at most capacity elements.
+// A capacity of zero or less means that the heap is unbounded.
+// A utility.Compare type providing a strict weak ordering.
+func NewBoundedMinMaxHeapWithComparator[T any](capacity int, comparator utility.Compare[T]) *MinMaxHeap[T] {
+	if capacity < 0 {
+		capacity = 0
+	}
+
+	return &MinMaxHeap[T]{
+		heapList: []T{},
+		capacity: capacity,
+		cmpInst:  comparator,
+	}
+}
+
+// NewBoundedMinMaxHeapWithComparatorFunc constructs the MinMaxHeap holding at most capacity elements.
+// A capacity of zero or less means that the heap is unbounded.
+// A less function providing a strict weak ordering, see utility.CompareFunc.
+func NewBoundedMinMaxHeapWithComparatorFunc[T any](capacity int, less func(lhs, rhs T) bool) *MinMaxHeap[T] {
+	return NewBoundedMinMaxHeapWithComparator[T](capacity, utility.CompareFunc[T](less))
+}
+
+// NewBoundedMinMaxHeapWithOrdering constructs the MinMaxHeap holding at most capacity elements.
+// A capacity of zero or less means that the heap is unbounded.
+// A utility.Ordering three-way comparator providing a strict weak ordering.
+func NewBoundedMinMaxHeapWithOrdering[T any](capacity int, ordering utility.Ordering[T]) *MinMaxHeap[T] {
+	return NewBoundedMinMaxHeapWithComparator[T](capacity, utility.ToCompare(ordering))
+}
+
+// Size returns the number of elements in the MinMaxHeap.
+// Complexity - constant.
+func (h *MinMaxHeap[T]) Size() int {
+	return len(h.heapList)
+}
+
+// Empty checks if the MinMaxHeap has no elements
+// Complexity - constant.
+func (h *MinMaxHeap[T]) Empty() bool {
+	return h.Size() == 0
+}
+
+// Capacity returns the maximum number of elements in the MinMaxHeap, zero if the heap is unbounded.
+// Complexity - constant.
+func (h *MinMaxHeap[T]) Capacity() int {
+	return h.capacity
+}
+
+// Push pushes the given element value to the MinMaxHeap.
+// If the heap is bounded and full, the smallest element is dropped, see PushEvict.
+// Complexity - logarithmic number of comparisons.
+func (h *MinMaxHeap[T]) Push(value T) {
+	h.PushEvict(value)
+}
+
+// PushEvict pushes the given element value to the MinMaxHeap.
+// If the heap is bounded and full, the smallest element among the stored ones and value is dropped.
+// Returns the dropped element and true if an element was dropped, the zero value of T and false otherwise.
+// Complexity - logarithmic number of comparisons.
+func (h *MinMaxHeap[T]) PushEvict(value T) (T, bool) {
+	if h.capacity > 0 && h.Size() == h.capacity {
+		if !h.cmpInst.Cmp(h.heapList[0], value) {
+			return value, true
+		}
+
+		evicted := h.heapList[0]
+		h.heapList[0] = value
+
+		h.trickleDown(0)
+
+		return evicted, true
+	}
+
+	h.heapList = append(h.heapList, value)
+
+	h.bubbleUp(h.Size() - 1)
+
+	var emptyEl T
+
+	return emptyEl, false
+}
+
+// PeekMin returns reference to the smallest element in the MinMaxHeap.
+// Calling PeekMin on an empty MinMaxHeap panics with containers.ErrEmpty, see TryPeekMin.
+// Complexity - constant.
+func (h *MinMaxHeap[T]) PeekMin() T {
+	if h.Empty() {
+		panic(containers.ErrEmpty)
+	}
+
+	return h.heapList[0]
+}
+
+// PeekMax returns reference to the largest element in the MinMaxHeap.
+// Calling PeekMax on an empty MinMaxHeap panics with containers.ErrEmpty, see TryPeekMax.
+// Complexity - constant.
+func (h *MinMaxHeap[T]) PeekMax() T {
+	if h.Empty() {
+		panic(containers.ErrEmpty)
+	}
+
+	return h.heapList[h.maxIndex()]
+}
+
+// PopMin removes the smallest element from the MinMaxHeap.
+// Calling PopMin on an empty MinMaxHeap panics with containers.ErrEmpty, see TryPopMin.
+// Complexity - logarithmic number of comparisons.
+func (h *MinMaxHeap[T]) PopMin() T {
+	return h.removeAt(0)
+}
+
+// PopMax removes the largest element from the MinMaxHeap.
+// Calling PopMax on an empty MinMaxHeap panics with containers.ErrEmpty, see TryPopMax.
+// Complexity - logarithmic number of comparisons.
+func (h *MinMaxHeap[T]) PopMax() T {
+	if h.Empty() {
+		panic(containers.ErrEmpty)
+	}
+
+	return h.removeAt(h.maxIndex())
+}
+
+// Top is an alias for PeekMax.
+func (h *MinMaxHeap[T]) Top() T {
+	return h.PeekMax()
+}
+
+// Pop is an alias for PopMax.
+func (h *MinMaxHeap[T]) Pop() T {
+	return h.PopMax()
+}
+
+// TryPeekMin returns the smallest element in the MinMaxHeap.
+// Returns the zero value of T and false if the MinMaxHeap is empty.
+// Complexity - constant.
+func (h *MinMaxHeap[T]) TryPeekMin() (T, bool) {
+	if h.Empty() {
+		var emptyEl T
+
+		return emptyEl, false
+	}
+
+	return h.PeekMin(), true
+}
+
+// TryPeekMax returns the largest element in the MinMaxHeap.
+// Returns the zero value of T and false if the MinMaxHeap is empty.
+// Complexity - constant.
+func (h *MinMaxHeap[T]) TryPeekMax() (T, bool) {
+	if h.Empty() {
+		var emptyEl T
+
+		return emptyEl, false
+	}
+
+	return h.PeekMax(), true
+}
+
+// TryPopMin removes the smallest element from the MinMaxHeap.
+// Returns the zero value of T and false if the MinMaxHeap is empty.
+// Complexity - logarithmic number of comparisons.
+func (h *MinMaxHeap[T]) TryPopMin() (T, bool) {
+	if h.Empty() {
+		var emptyEl T
+
+		return emptyEl, false
+	}
+
+	return h.PopMin(), true
+}
+
+// TryPopMax removes the largest element from the MinMaxHeap.
+// Returns the zero value of T and false if the MinMaxHeap is empty.
+// Complexity - logarithmic number of comparisons.
+func (h *MinMaxHeap[T]) TryPopMax() (T, bool) {
+	if h.Empty() {
+		var emptyEl T
+
+		return emptyEl, false
+	}
+
+	return h.PopMax(), true
+}
+
+// TryTop is an alias for TryPeekMax.
+func (h *MinMaxHeap[T]) TryTop() (T, bool) {
+	return h.TryPeekMax()
+}
+
+// TryPop is an alias for TryPopMax.
+func (h *MinMaxHeap[T]) TryPop() (T, bool) {
+	return h.TryPopMax()
+}
+
+func (h *MinMaxHeap[T]) less(i, j int) bool {
+	return h.cmpInst.Cmp(h.heapList[i], h.heapList[j])
+}
+
+func (h *MinMaxHeap[T]) swap(i, j int) {
+	h.heapList[i], h.heapList[j] = h.heapList[j], h.heapList[i]
+}
+
+// maxIndex returns the index of the largest element which is either the root or one of its children.
+func (h *MinMaxHeap[T]) maxIndex() int {
+	switch {
+	case h.Size() == 1:
+		return 0
+	case h.Size() == 2 || h.less(2, 1):
+		return 1
+	default:
+		return 2
+	}
+}
+
+func (h *MinMaxHeap[T]) removeAt(i int) T {
+	if h.Empty() {
+		panic(containers.ErrEmpty)
+	}
+
+	var emptyEl T
+
+	last := h.Size() - 1
+	value := h.heapList[i]
+
+	h.heapList[i] = h.heapList[last]
+	// clear the vacated slot so the removed element does not stay reachable
+	h.heapList[last] = emptyEl
+	h.heapList = h.heapList[:last]
+
+	if i < last {
+		h.trickleDown(i)
+	}
+
+	return value
+}
+
+// isMinLevel checks if the element at index i lies on an even level of the tree.
+func isMinLevel(i int) bool {
+	return bits.Len(uint(i+1))%2 == 1
+}
+
+func (h *MinMaxHeap[T]) bubbleUp(i int) {
+	if i == 0 {
+		return
+	}
+
+	parent := (i - 1) / 2
+
+	// the ordering predicate of the levels the element moves along
+	before := h.less
+
+	if isMinLevel(i) {
+		if h.less(parent, i) {
+			h.swap(i, parent)
+			i = parent
+			before = func(a, b int) bool { return h.less(b, a) }
+		}
+	} else {
+		if h.less(i, parent) {
+			h.swap(i, parent)
+			i = parent
+		} else {
+			before = func(a, b int) bool { return h.less(b, a) }
+		}
+	}
+
+	for i > 2 {
+		grandparent := ((i-1)/2 - 1) / 2
+
+		if !before(i, grandparent) {
+			return
+		}
+
+		h.swap(i, grandparent)
+		i = grandparent
+	}
+}
+
+func (h *MinMaxHeap[T]) trickleDown(i int) {
+	before := h.less
+
+	if !isMinLevel(i) {
+		before = func(a, b int) bool { return h.less(b, a) }
+	}
+
+	for {
+		m, isGrandchild := h.extremeDescendant(i, before)
+
+		if m < 0 || !before(m, i) {
+			return
+		}
+
+		h.swap(m, i)
+
+		if !isGrandchild {
+			return
+		}
+
+		if parent := (m - 1) / 2; before(parent, m) {
+			h.swap(m, parent)
+		}
+
+		i = m
+	}
+}
+
+// extremeDescendant returns the index of the child or grandchild of i that should be ordered first
+// according to before and whether it is a grandchild. Returns -1 if i has no children.
+func (h *MinMaxHeap[T]) extremeDescendant(i int, before func(a, b int) bool) (int, bool) {
+	m, isGrandchild := -1, false
+
+	for child := 2*i + 1; child <= 2*i+2 && child < h.Size(); child++ {
+		if m < 0 || before(child, m) {
+			m, isGrandchild = child, false
+		}
+
+		for grandchild := 2*child + 1; grandchild <= 2*child+2 && grandchild < h.Size(); grandchild++ {
+			if before(grandchild, m) {
+				m, isGrandchild = grandchild, true
+			}
+		}
+	}
+
+	return m, isGrandchild
+}
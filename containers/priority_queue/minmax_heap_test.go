@@ -0,0 +1,144 @@
+// Copyright 2021. The GTL Authors. All rights reserved.
+// https://github.com/modern-dev/gtl
+// Use of this source code is governed by the MIT
+// license that can be found in the LICENSE file.
+
+package priority_queue
+
+import (
+	"math/rand"
+	"sort"
+	"testing"
+
+	"github.com/modern-dev/gtl/utility"
+)
+
+var _ Interface[int] = NewMinMaxHeap[int]()
+
+func TestMinMaxHeap(t *testing.T) {
+	h := NewMinMaxHeap[int]()
+
+	for _, v := range []int{5, 6, 7, 9, 14, 11, 10} {
+		h.Push(v)
+	}
+
+	if h.PeekMin() != 5 || h.PeekMax() != 14 {
+		t.Errorf("Expected MinMaxHeap to span [%d, %d], got [%d, %d]", 5, 14, h.PeekMin(), h.PeekMax())
+	}
+
+	checkDrain[int](h, []int{14, 11, 10, 9, 7, 6, 5}, t)
+}
+
+func TestMinMaxHeapRandom(t *testing.T) {
+	rnd := rand.New(rand.NewSource(42))
+
+	for n := 1; n < 200; n += 7 {
+		h := NewMinMaxHeapWithComparator[int](&utility.Less[int]{})
+		items := make([]int, n)
+
+		for i := range items {
+			items[i] = rnd.Intn(50)
+			h.Push(items[i])
+		}
+
+		sort.Ints(items)
+
+		// pop from both ends alternately
+		lo, hi := 0, n-1
+
+		for i := 0; !h.Empty(); i++ {
+			if i%2 == 0 {
+				if got := h.PopMin(); got != items[lo] {
+					t.Fatalf("PopMin() = %v, want %v", got, items[lo])
+				}
+
+				lo++
+			} else {
+				if got := h.PopMax(); got != items[hi] {
+					t.Fatalf("PopMax() = %v, want %v", got, items[hi])
+				}
+
+				hi--
+			}
+		}
+
+		if lo != hi+1 {
+			t.Errorf("Expected to pop %d elements, got %d", n, lo+n-1-hi)
+		}
+	}
+}
+
+func TestBoundedMinMaxHeap(t *testing.T) {
+	h := NewBoundedMinMaxHeap[int](3)
+
+	if h.Capacity() != 3 {
+		t.Errorf("Expected capacity to be %d, got %d", 3, h.Capacity())
+	}
+
+	cases := []struct {
+		value   int
+		evicted int
+		ok      bool
+	}{
+		{5, 0, false},
+		{1, 0, false},
+		{9, 0, false},
+		{7, 1, true},
+		{2, 2, true},
+		{8, 5, true},
+	}
+
+	for _, c := range cases {
+		if evicted, ok := h.PushEvict(c.value); evicted != c.evicted || ok != c.ok {
+			t.Errorf("PushEvict(%d) = (%d, %t), want (%d, %t)", c.value, evicted, ok, c.evicted, c.ok)
+		}
+	}
+
+	checkDrain[int](h, []int{9, 8, 7}, t)
+}
+
+func TestMinMaxHeapPopReleases(t *testing.T) {
+	h := NewMinMaxHeapWithComparatorFunc(func(lhs, rhs *int) bool { return *lhs < *rhs })
+	one, two, three := 1, 2, 3
+
+	h.Push(&one)
+	h.Push(&two)
+	h.Push(&three)
+	h.PopMin()
+	h.PopMax()
+
+	// the removed elements must not stay reachable through the backing array
+	for i, vacated := range h.heapList[1:3] {
+		if vacated != nil {
+			t.Errorf("Expected the vacated slot %d to be cleared, got %v", i+1, *vacated)
+		}
+	}
+}
+
+func TestMinMaxHeapTryAccessors(t *testing.T) {
+	h := NewMinMaxHeap[int]()
+
+	if _, ok := h.TryPeekMin(); ok {
+		t.Errorf("Expected TryPeekMin() on an empty heap to fail")
+	}
+
+	if _, ok := h.TryPopMax(); ok {
+		t.Errorf("Expected TryPopMax() on an empty heap to fail")
+	}
+
+	h.Push(1)
+	h.Push(3)
+	h.Push(2)
+
+	if el, ok := h.TryPeekMax(); !ok || el != 3 {
+		t.Errorf("Expected to get (%d, %t), got (%d, %t)", 3, true, el, ok)
+	}
+
+	if el, ok := h.TryPopMin(); !ok || el != 1 {
+		t.Errorf("Expected to get (%d, %t), got (%d, %t)", 1, true, el, ok)
+	}
+
+	if el, ok := h.TryPopMax(); !ok || el != 3 {
+		t.Errorf("Expected to get (%d, %t), got (%d, %t)", 3, true, el, ok)
+	}
+}
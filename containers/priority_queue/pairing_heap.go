@@ -0,0 +1,189 @@
+// Copyright 2021. The GTL Authors. All rights reserved.
+// https://github.com/modern-dev/gtl
+// Use of this source code is governed by the MIT
+// license that can be found in the LICENSE file.
+
+package priority_queue
+
+import (
+	"constraints"
+
+	"github.com/modern-dev/gtl/containers"
+	"github.com/modern-dev/gtl/utility"
+)
+
+type (
+	// A PairingHeap is a mergeable priority queue based on a multiway tree.
+	// It provides constant time insertion and melding, at the expense of amortized logarithmic extraction.
+	// Like PriorityQueue, the largest (by default) element appears as the Top().
+	PairingHeap[T any] struct {
+		root    *pairingNode[T]
+		size    int
+		cmpInst utility.Compare[T]
+	}
+
+	// pairingNode is a node of the PairingHeap stored in the leftmost child, right sibling form.
+	pairingNode[T any] struct {
+		value   T
+		child   *pairingNode[T]
+		sibling *pairingNode[T]
+	}
+)
+
+// NewPairingHeap constructs the PairingHeap.
+func NewPairingHeap[T constraints.Ordered]() *PairingHeap[T] {
+	return NewPairingHeapWithComparator[T](&utility.Less[T]{})
+}
+
+// NewPairingHeapWithComparator constructs the PairingHeap.
+// A utility.Compare type providing a strict weak ordering.
+func NewPairingHeapWithComparator[T any](comparator utility.Compare[T]) *PairingHeap[T] {
+	return &PairingHeap[T]{cmpInst: comparator}
+}
+
+// NewPairingHeapWithComparatorFunc constructs the PairingHeap.
+// A less function providing a strict weak ordering, see utility.CompareFunc.
+func NewPairingHeapWithComparatorFunc[T any](less func(lhs, rhs T) bool) *PairingHeap[T] {
+	return NewPairingHeapWithComparator[T](utility.CompareFunc[T](less))
+}
+
+// NewPairingHeapWithOrdering constructs the PairingHeap.
+// A utility.Ordering three-way comparator providing a strict weak ordering.
+func NewPairingHeapWithOrdering[T any](ordering utility.Ordering[T]) *PairingHeap[T] {
+	return NewPairingHeapWithComparator[T](utility.ToCompare(ordering))
+}
+
+// Size returns the number of elements in the PairingHeap.
+// Complexity - constant.
+func (h *PairingHeap[T]) Size() int {
+	return h.size
+}
+
+// Empty checks if the PairingHeap has no elements
+// Complexity - constant.
+func (h *PairingHeap[T]) Empty() bool {
+	return h.Size() == 0
+}
+
+// Push pushes the given element value to the PairingHeap.
+// Complexity - constant.
+func (h *PairingHeap[T]) Push(value T) {
+	h.root = h.meld(h.root, &pairingNode[T]{value: value})
+	h.size++
+}
+
+// Pop removes the top element from the PairingHeap.
+// Calling Pop on an empty PairingHeap panics with containers.ErrEmpty, see TryPop.
+// Complexity - amortized logarithmic.
+func (h *PairingHeap[T]) Pop() T {
+	if h.Empty() {
+		panic(containers.ErrEmpty)
+	}
+
+	root := h.root
+
+	h.root = h.mergePairs(root.child)
+	h.size--
+
+	return root.value
+}
+
+// Top returns reference to the top element in the PairingHeap.
+// Calling Top on an empty PairingHeap panics with containers.ErrEmpty, see TryTop.
+// Complexity - constant.
+func (h *PairingHeap[T]) Top() T {
+	if h.Empty() {
+		panic(containers.ErrEmpty)
+	}
+
+	return h.root.value
+}
+
+// TryTop returns the top element in the PairingHeap.
+// Returns the zero value of T and false if the PairingHeap is empty.
+// Complexity - constant.
+func (h *PairingHeap[T]) TryTop() (T, bool) {
+	if h.Empty() {
+		var emptyEl T
+
+		return emptyEl, false
+	}
+
+	return h.Top(), true
+}
+
+// TryPop removes the top element from the PairingHeap.
+// Returns the zero value of T and false if the PairingHeap is empty.
+// Complexity - the same as for Pop.
+func (h *PairingHeap[T]) TryPop() (T, bool) {
+	if h.Empty() {
+		var emptyEl T
+
+		return emptyEl, false
+	}
+
+	return h.Pop(), true
+}
+
+// Merge moves all elements of other into the PairingHeap, other is left empty.
+// Both heaps are expected to use the same ordering.
+// Complexity - constant.
+func (h *PairingHeap[T]) Merge(other *PairingHeap[T]) {
+	if h == other {
+		return
+	}
+
+	h.root = h.meld(h.root, other.root)
+	h.size += other.size
+
+	other.root, other.size = nil, 0
+}
+
+// meld links two trees making the root with the lower priority the leftmost child of the other one.
+func (h *PairingHeap[T]) meld(a, b *pairingNode[T]) *pairingNode[T] {
+	if a == nil {
+		return b
+	}
+
+	if b == nil {
+		return a
+	}
+
+	if h.cmpInst.Cmp(a.value, b.value) {
+		a, b = b, a
+	}
+
+	b.sibling = a.child
+	a.child = b
+
+	return a
+}
+
+// mergePairs melds the list of siblings starting with first into a single tree using the two-pass scheme:
+// the siblings are melded in pairs from left to right and the pairs are then melded from right to left.
+func (h *PairingHeap[T]) mergePairs(first *pairingNode[T]) *pairingNode[T] {
+	var pairs []*pairingNode[T]
+
+	for first != nil {
+		a, b := first, first.sibling
+
+		if b == nil {
+			pairs = append(pairs, a)
+
+			break
+		}
+
+		first = b.sibling
+		a.sibling, b.sibling = nil, nil
+
+		pairs = append(pairs, h.meld(a, b))
+	}
+
+	var root *pairingNode[T]
+
+	for i := len(pairs) - 1; i >= 0; i-- {
+		root = h.meld(pairs[i], root)
+	}
+
+	return root
+}
@@ -8,46 +8,98 @@ package priority_queue
 
 import (
 	"constraints"
+
+	"github.com/modern-dev/gtl/algo"
+	"github.com/modern-dev/gtl/containers"
 	"github.com/modern-dev/gtl/utility"
 )
 
 type (
+	// Interface is implemented by every priority queue of the package.
+	// The largest element according to the comparator is the one returned by Top and removed by Pop.
+	Interface[T any] interface {
+		Size() int
+		Empty() bool
+		Push(value T)
+		Pop() T
+		Top() T
+		TryPop() (T, bool)
+		TryTop() (T, bool)
+	}
+
 	// A PriorityQueue is a container adaptor that provides constant time lookup of the largest (by default) element,
 	// at the expense of logarithmic insertion and extraction.
 	// A user-provided comparator can be supplied to change the ordering, e.g. using utility.Greater[T]
 	// would cause the smallest element to appear as the Top().
+	// The elements are kept in a slice arranged by the heap algorithms of the algo package.
 	PriorityQueue[T any] struct {
 		heapList []T
-		size     int
 		cmpInst  utility.Compare[T]
 	}
 )
 
 // NewPriorityQueue constructs the PriorityQueue.
 func NewPriorityQueue[T constraints.Ordered]() *PriorityQueue[T] {
-	var emptyEl T
-
-	return &PriorityQueue[T]{
-		heapList: []T{emptyEl},
-		cmpInst:  &utility.Less[T]{},
-	}
+	return NewPriorityQueueWithComparator[T](&utility.Less[T]{})
 }
 
 // NewPriorityQueueWithComparator constructs the PriorityQueue.
 // A utility.Compare type providing a strict weak ordering.
 func NewPriorityQueueWithComparator[T any](comparator utility.Compare[T]) *PriorityQueue[T] {
-	var emptyEl T
-
 	return &PriorityQueue[T]{
-		heapList: []T{emptyEl},
+		heapList: []T{},
 		cmpInst:  comparator,
 	}
 }
 
+// NewPriorityQueueFrom constructs the PriorityQueue holding a copy of the given items.
+// Complexity - linear in the number of items.
+func NewPriorityQueueFrom[T constraints.Ordered](items []T) *PriorityQueue[T] {
+	return NewPriorityQueueFromWithComparator[T](items, &utility.Less[T]{})
+}
+
+// NewPriorityQueueFromWithComparator constructs the PriorityQueue holding a copy of the given items.
+// A utility.Compare type providing a strict weak ordering.
+// Complexity - linear in the number of items.
+func NewPriorityQueueFromWithComparator[T any](items []T, comparator utility.Compare[T]) *PriorityQueue[T] {
+	h := NewPriorityQueueWithComparator[T](comparator)
+	h.heapList = append(h.heapList, items...)
+
+	algo.MakeHeap(h.heapList, h.cmpInst)
+
+	return h
+}
+
+// NewPriorityQueueFromWithComparatorFunc constructs the PriorityQueue holding a copy of the given items.
+// A less function providing a strict weak ordering, see utility.CompareFunc.
+// Complexity - linear in the number of items.
+func NewPriorityQueueFromWithComparatorFunc[T any](items []T, less func(lhs, rhs T) bool) *PriorityQueue[T] {
+	return NewPriorityQueueFromWithComparator[T](items, utility.CompareFunc[T](less))
+}
+
+// NewPriorityQueueFromWithOrdering constructs the PriorityQueue holding a copy of the given items.
+// A utility.Ordering three-way comparator providing a strict weak ordering.
+// Complexity - linear in the number of items.
+func NewPriorityQueueFromWithOrdering[T any](items []T, ordering utility.Ordering[T]) *PriorityQueue[T] {
+	return NewPriorityQueueFromWithComparator[T](items, utility.ToCompare(ordering))
+}
+
+// NewPriorityQueueWithComparatorFunc constructs the PriorityQueue.
+// A less function providing a strict weak ordering, see utility.CompareFunc.
+func NewPriorityQueueWithComparatorFunc[T any](less func(lhs, rhs T) bool) *PriorityQueue[T] {
+	return NewPriorityQueueWithComparator[T](utility.CompareFunc[T](less))
+}
+
+// NewPriorityQueueWithOrdering constructs the PriorityQueue.
+// A utility.Ordering three-way comparator providing a strict weak ordering.
+func NewPriorityQueueWithOrdering[T any](ordering utility.Ordering[T]) *PriorityQueue[T] {
+	return NewPriorityQueueWithComparator[T](utility.ToCompare(ordering))
+}
+
 // Size returns the number of elements in the PriorityQueue.
 // Complexity - constant.
 func (h *PriorityQueue[T]) Size() int {
-	return h.size
+	return len(h.heapList)
 }
 
 // Empty checks if the PriorityQueue has no elements
@@ -60,61 +112,80 @@ func (h *PriorityQueue[T]) Empty() bool {
 // Complexity - logarithmic number of comparisons.
 func (h *PriorityQueue[T]) Push(value T) {
 	h.heapList = append(h.heapList, value)
-	h.size++
 
-	h.siftUp(h.size)
+	algo.PushHeap(h.heapList, h.cmpInst)
 }
 
 // Pop removes the top element from the PriorityQueue
+// Calling Pop on an empty PriorityQueue panics with containers.ErrEmpty, see TryPop.
 // Complexity - logarithmic number of comparisons.
 func (h *PriorityQueue[T]) Pop() T {
-	root := h.heapList[1]
+	if h.Empty() {
+		panic(containers.ErrEmpty)
+	}
+
+	last := h.Size() - 1
 
-	h.heapList[1] = h.heapList[h.size]
-	h.heapList = h.heapList[:len(h.heapList)-1]
-	h.size--
+	algo.PopHeap(h.heapList, h.cmpInst)
 
-	h.siftDown(1)
+	var emptyEl T
+
+	root := h.heapList[last]
+	// clear the vacated slot so the popped element does not stay reachable
+	h.heapList[last] = emptyEl
+	h.heapList = h.heapList[:last]
 
 	return root
 }
 
+// Merge moves all elements of other into the PriorityQueue, other is left empty.
+// Both queues are expected to use the same ordering.
+// Complexity - linear in the total number of elements.
+func (h *PriorityQueue[T]) Merge(other *PriorityQueue[T]) {
+	if h == other {
+		return
+	}
+
+	h.heapList = append(h.heapList, other.heapList...)
+	// drop the backing array, so the moved elements are not kept alive by other
+	other.heapList = []T{}
+
+	algo.MakeHeap(h.heapList, h.cmpInst)
+}
+
 // Top returns reference to the top element in the PriorityQueue.
+// Calling Top on an empty PriorityQueue panics with containers.ErrEmpty, see TryTop.
 // Complexity - constant.
 func (h *PriorityQueue[T]) Top() T {
-	return h.heapList[1]
-}
-
-func (h *PriorityQueue[T]) siftUp(i int) {
-	for i/2 > 0 {
-		if !h.cmpInst.Cmp(h.heapList[i], h.heapList[i/2]) {
-			h.heapList[i], h.heapList[i/2] = h.heapList[i/2], h.heapList[i]
-		}
-
-		i /= 2
+	if h.Empty() {
+		panic(containers.ErrEmpty)
 	}
+
+	return h.heapList[0]
 }
 
-func (h *PriorityQueue[T]) minChild(i int) int {
-	if (i*2)+1 > h.size {
-		return i * 2
-	}
+// TryTop returns the top element in the PriorityQueue.
+// Returns the zero value of T and false if the PriorityQueue is empty.
+// Complexity - constant.
+func (h *PriorityQueue[T]) TryTop() (T, bool) {
+	if h.Empty() {
+		var emptyEl T
 
-	if !h.cmpInst.Cmp(h.heapList[i*2], h.heapList[i*2+1]) {
-		return i * 2
+		return emptyEl, false
 	}
 
-	return i*2 + 1
+	return h.Top(), true
 }
 
-func (h *PriorityQueue[T]) siftDown(i int) {
-	for (i * 2) <= h.size {
-		mc := h.minChild(i)
+// TryPop removes the top element from the PriorityQueue.
+// Returns the zero value of T and false if the PriorityQueue is empty.
+// Complexity - the same as for Pop.
+func (h *PriorityQueue[T]) TryPop() (T, bool) {
+	if h.Empty() {
+		var emptyEl T
 
-		if h.cmpInst.Cmp(h.heapList[i], h.heapList[mc]) {
-			h.heapList[i], h.heapList[mc] = h.heapList[mc], h.heapList[i]
-		}
-
-		i = mc
+		return emptyEl, false
 	}
+
+	return h.Pop(), true
 }
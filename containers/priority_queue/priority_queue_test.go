@@ -5,7 +5,12 @@
 
 package priority_queue
 
-import "testing"
+import (
+	"testing"
+
+	"github.com/modern-dev/gtl/containers"
+	"github.com/modern-dev/gtl/utility"
+)
 
 func TestPriorityQueue(t *testing.T) {
 	t.Run("test1", func(t *testing.T) {
@@ -30,3 +35,243 @@ func TestPriorityQueue(t *testing.T) {
 		}
 	})
 }
+
+func TestPriorityQueueEncoding(t *testing.T) {
+	pq := NewPriorityQueueWithComparator[int](&utility.Greater[int]{})
+
+	for _, v := range []int{5, 6, 7, 9, 14, 11, 10} {
+		pq.Push(v)
+	}
+
+	bin, err := pq.MarshalBinary()
+	if err != nil {
+		t.Fatalf("MarshalBinary() failed: %v", err)
+	}
+
+	js, err := pq.MarshalJSON()
+	if err != nil {
+		t.Fatalf("MarshalJSON() failed: %v", err)
+	}
+
+	fromBin := NewPriorityQueueWithComparator[int](&utility.Greater[int]{})
+	fromJSON := NewPriorityQueue[int]()
+
+	if err := fromBin.UnmarshalBinary(bin); err != nil {
+		t.Fatalf("UnmarshalBinary() failed: %v", err)
+	}
+
+	if err := fromJSON.UnmarshalJSON(js); err != nil {
+		t.Fatalf("UnmarshalJSON() failed: %v", err)
+	}
+
+	for _, want := range []int{5, 6, 7, 9, 10, 11, 14} {
+		if got := fromBin.Pop(); got != want {
+			t.Errorf("Pop() = %v, want %v", got, want)
+		}
+	}
+
+	// the ordering is rebuilt using the comparator of the receiver
+	for _, want := range []int{14, 11, 10, 9, 7, 6, 5} {
+		if got := fromJSON.Pop(); got != want {
+			t.Errorf("Pop() = %v, want %v", got, want)
+		}
+	}
+
+	var zero PriorityQueue[int]
+
+	if err := zero.UnmarshalJSON(js); err != ErrNoComparator {
+		t.Errorf("Expected UnmarshalJSON() to fail with %v, got %v", ErrNoComparator, err)
+	}
+}
+
+func TestPriorityQueueWithOrdering(t *testing.T) {
+	pq := NewPriorityQueueWithOrdering[string](utility.CaseInsensitive().Reverse())
+
+	for _, v := range []string{"delta", "Alpha", "charlie", "Bravo"} {
+		pq.Push(v)
+	}
+
+	for _, want := range []string{"Alpha", "Bravo", "charlie", "delta"} {
+		if got := pq.Pop(); got != want {
+			t.Errorf("Pop() = %v, want %v", got, want)
+		}
+	}
+}
+
+func TestPriorityQueueWithComparatorFunc(t *testing.T) {
+	type job struct {
+		name     string
+		deadline int
+	}
+
+	pq := NewPriorityQueueWithComparatorFunc(func(lhs, rhs job) bool { return lhs.deadline > rhs.deadline })
+
+	pq.Push(job{"report", 30})
+	pq.Push(job{"deploy", 10})
+	pq.Push(job{"review", 20})
+
+	for _, want := range []string{"deploy", "review", "report"} {
+		if got := pq.Pop(); got.name != want {
+			t.Errorf("Pop() = %v, want %v", got.name, want)
+		}
+	}
+}
+
+func TestHeapsWithOrdering(t *testing.T) {
+	ordering := utility.CaseInsensitive().Reverse()
+	longer := func(lhs, rhs string) bool { return len(lhs) < len(rhs) }
+	alphabetical, byLength := []string{"Alpha", "b", "charlie", "Dd"}, []string{"charlie", "Alpha", "Dd", "b"}
+
+	heaps := []struct {
+		pq   Interface[string]
+		want []string
+	}{
+		{NewMinMaxHeapWithOrdering[string](ordering), alphabetical},
+		{NewLeftistHeapWithOrdering[string](ordering), alphabetical},
+		{NewPairingHeapWithOrdering[string](ordering), alphabetical},
+		{NewStablePriorityQueueWithOrdering[string](ordering), alphabetical},
+		{NewMinMaxHeapWithComparatorFunc(longer), byLength},
+		{NewLeftistHeapWithComparatorFunc(longer), byLength},
+		{NewPairingHeapWithComparatorFunc(longer), byLength},
+		{NewBoundedMinMaxHeapWithOrdering[string](4, ordering), alphabetical},
+		{NewBoundedMinMaxHeapWithComparatorFunc(4, longer), byLength},
+	}
+
+	for _, h := range heaps {
+		for _, v := range []string{"Dd", "Alpha", "charlie", "b"} {
+			h.pq.Push(v)
+		}
+
+		checkDrain(h.pq, h.want, t)
+	}
+
+	items := []string{"Dd", "Alpha", "charlie", "b"}
+
+	checkDrain[string](NewPriorityQueueFromWithOrdering(items, ordering), alphabetical, t)
+	checkDrain[string](NewPriorityQueueFromWithComparatorFunc(items, longer), byLength, t)
+}
+
+func TestNewPriorityQueueFrom(t *testing.T) {
+	items := []int{5, 6, 7, 9, 14, 11, 10}
+	pq := NewPriorityQueueFrom(items)
+
+	items[0] = 100
+
+	checkDrain[int](pq, []int{14, 11, 10, 9, 7, 6, 5}, t)
+}
+
+func TestPriorityQueueMerge(t *testing.T) {
+	pq := NewPriorityQueueFrom([]int{1, 5, 3})
+	other := NewPriorityQueueFrom([]int{4, 2, 6})
+
+	pq.Merge(other)
+
+	if !other.Empty() {
+		t.Errorf("Expected merged PriorityQueue to be empty, got size {%d}", other.Size())
+	}
+
+	if cap(other.heapList) != 0 {
+		t.Errorf("Expected merged PriorityQueue to release its elements, got capacity {%d}", cap(other.heapList))
+	}
+
+	checkDrain[int](pq, []int{6, 5, 4, 3, 2, 1}, t)
+}
+
+func checkDrain[T comparable](pq Interface[T], want []T, t *testing.T) {
+	if pq.Size() != len(want) {
+		t.Errorf("Expected PriorityQueue size to be {%d}, got {%d} instead", len(want), pq.Size())
+	}
+
+	for _, w := range want {
+		if got := pq.Top(); got != w {
+			t.Errorf("Top() = %v, want %v", got, w)
+		}
+
+		if got := pq.Pop(); got != w {
+			t.Errorf("Pop() = %v, want %v", got, w)
+		}
+	}
+
+	if !pq.Empty() {
+		t.Errorf("Expected PriorityQueue to be empty, got size {%d}", pq.Size())
+	}
+}
+
+func TestPriorityQueueTryAccessors(t *testing.T) {
+	queues := map[string]Interface[int]{
+		"PriorityQueue":       NewPriorityQueue[int](),
+		"PairingHeap":         NewPairingHeap[int](),
+		"LeftistHeap":         NewLeftistHeap[int](),
+		"MinMaxHeap":          NewMinMaxHeap[int](),
+		"StablePriorityQueue": NewStablePriorityQueue[int](),
+	}
+
+	for name, pq := range queues {
+		t.Run(name, func(t *testing.T) {
+			if _, ok := pq.TryTop(); ok {
+				t.Errorf("Expected TryTop() on an empty queue to fail")
+			}
+
+			if _, ok := pq.TryPop(); ok {
+				t.Errorf("Expected TryPop() on an empty queue to fail")
+			}
+
+			pq.Push(0)
+
+			if el, ok := pq.TryTop(); !ok || el != 0 {
+				t.Errorf("Expected to get (%d, %t), got (%d, %t)", 0, true, el, ok)
+			}
+
+			if el, ok := pq.TryPop(); !ok || el != 0 {
+				t.Errorf("Expected to get (%d, %t), got (%d, %t)", 0, true, el, ok)
+			}
+
+			defer func() {
+				if r := recover(); r != containers.ErrEmpty {
+					t.Errorf("Expected Pop() on an empty queue to panic with %v, got %v", containers.ErrEmpty, r)
+				}
+			}()
+
+			pq.Pop()
+		})
+	}
+}
+
+func TestPriorityQueuePopReleases(t *testing.T) {
+	pq := NewPriorityQueueWithComparatorFunc(func(lhs, rhs *int) bool { return *lhs < *rhs })
+	one, two := 1, 2
+
+	pq.Push(&one)
+	pq.Push(&two)
+	pq.Pop()
+
+	// the popped element must not stay reachable through the backing array
+	if vacated := pq.heapList[:2][1]; vacated != nil {
+		t.Errorf("Expected the vacated slot to be cleared, got %v", *vacated)
+	}
+}
+
+func TestPriorityQueueEmptyPeek(t *testing.T) {
+	mmh := NewMinMaxHeap[int]()
+	peeks := map[string]func() int{
+		"PriorityQueue":       NewPriorityQueue[int]().Top,
+		"PairingHeap":         NewPairingHeap[int]().Top,
+		"LeftistHeap":         NewLeftistHeap[int]().Top,
+		"StablePriorityQueue": NewStablePriorityQueue[int]().Top,
+		"MinMaxHeap.Top":      mmh.Top,
+		"MinMaxHeap.PeekMin":  mmh.PeekMin,
+		"MinMaxHeap.PeekMax":  mmh.PeekMax,
+	}
+
+	for name, peek := range peeks {
+		t.Run(name, func(t *testing.T) {
+			defer func() {
+				if r := recover(); r != containers.ErrEmpty {
+					t.Errorf("Expected peeking into an empty queue to panic with %v, got %v", containers.ErrEmpty, r)
+				}
+			}()
+
+			peek()
+		})
+	}
+}
@@ -0,0 +1,126 @@
+// Copyright 2021. The GTL Authors. All rights reserved.
+// https://github.com/modern-dev/gtl
+// Use of this source code is governed by the MIT
+// license that can be found in the LICENSE file.
+
+package priority_queue
+
+import (
+	"constraints"
+
+	"github.com/modern-dev/gtl/utility"
+)
+
+type (
+	// A StablePriorityQueue is a PriorityQueue that guarantees first-in, first-out order among the elements
+	// that are equivalent according to the comparator: of two equivalent elements the one pushed earlier
+	// appears as the Top() first.
+	// The guarantee is implemented by tiebreaking on a monotonically increasing insertion sequence number.
+	StablePriorityQueue[T any] struct {
+		pq  *PriorityQueue[stableEntry[T]]
+		seq uint64
+	}
+
+	// stableEntry is an element of StablePriorityQueue tagged with its insertion sequence number.
+	stableEntry[T any] struct {
+		value T
+		seq   uint64
+	}
+)
+
+// NewStablePriorityQueue constructs the StablePriorityQueue.
+func NewStablePriorityQueue[T constraints.Ordered]() *StablePriorityQueue[T] {
+	return NewStablePriorityQueueWithComparator[T](&utility.Less[T]{})
+}
+
+// NewStablePriorityQueueWithComparator constructs the StablePriorityQueue.
+// A utility.Compare type providing a strict weak ordering.
+func NewStablePriorityQueueWithComparator[T any](comparator utility.Compare[T]) *StablePriorityQueue[T] {
+	stable := utility.CompareFunc[stableEntry[T]](func(lhs, rhs stableEntry[T]) bool {
+		if comparator.Cmp(lhs.value, rhs.value) {
+			return true
+		}
+
+		if comparator.Cmp(rhs.value, lhs.value) {
+			return false
+		}
+
+		// the element pushed later has the lower priority
+		return lhs.seq > rhs.seq
+	})
+
+	return &StablePriorityQueue[T]{
+		pq: NewPriorityQueueWithComparator[stableEntry[T]](stable),
+	}
+}
+
+// NewStablePriorityQueueWithComparatorFunc constructs the StablePriorityQueue.
+// A less function providing a strict weak ordering, see utility.CompareFunc.
+func NewStablePriorityQueueWithComparatorFunc[T any](less func(lhs, rhs T) bool) *StablePriorityQueue[T] {
+	return NewStablePriorityQueueWithComparator[T](utility.CompareFunc[T](less))
+}
+
+// NewStablePriorityQueueWithOrdering constructs the StablePriorityQueue.
+// A utility.Ordering three-way comparator providing a strict weak ordering.
+func NewStablePriorityQueueWithOrdering[T any](ordering utility.Ordering[T]) *StablePriorityQueue[T] {
+	return NewStablePriorityQueueWithComparator[T](utility.ToCompare(ordering))
+}
+
+// Size returns the number of elements in the StablePriorityQueue.
+// Complexity - constant.
+func (h *StablePriorityQueue[T]) Size() int {
+	return h.pq.Size()
+}
+
+// Empty checks if the StablePriorityQueue has no elements
+// Complexity - constant.
+func (h *StablePriorityQueue[T]) Empty() bool {
+	return h.pq.Empty()
+}
+
+// Push pushes the given element value to the StablePriorityQueue.
+// Complexity - logarithmic number of comparisons.
+func (h *StablePriorityQueue[T]) Push(value T) {
+	h.pq.Push(stableEntry[T]{value, h.seq})
+	h.seq++
+}
+
+// Pop removes the top element from the StablePriorityQueue
+// Calling Pop on an empty StablePriorityQueue panics with containers.ErrEmpty, see TryPop.
+// Complexity - logarithmic number of comparisons.
+func (h *StablePriorityQueue[T]) Pop() T {
+	return h.pq.Pop().value
+}
+
+// Top returns reference to the top element in the StablePriorityQueue.
+// Calling Top on an empty StablePriorityQueue panics with containers.ErrEmpty, see TryTop.
+// Complexity - constant.
+func (h *StablePriorityQueue[T]) Top() T {
+	return h.pq.Top().value
+}
+
+// TryTop returns the top element in the StablePriorityQueue.
+// Returns the zero value of T and false if the StablePriorityQueue is empty.
+// Complexity - constant.
+func (h *StablePriorityQueue[T]) TryTop() (T, bool) {
+	if h.Empty() {
+		var emptyEl T
+
+		return emptyEl, false
+	}
+
+	return h.Top(), true
+}
+
+// TryPop removes the top element from the StablePriorityQueue.
+// Returns the zero value of T and false if the StablePriorityQueue is empty.
+// Complexity - the same as for Pop.
+func (h *StablePriorityQueue[T]) TryPop() (T, bool) {
+	if h.Empty() {
+		var emptyEl T
+
+		return emptyEl, false
+	}
+
+	return h.Pop(), true
+}
@@ -0,0 +1,39 @@
+// Copyright 2021. The GTL Authors. All rights reserved.
+// https://github.com/modern-dev/gtl
+// Use of this source code is governed by the MIT
+// license that can be found in the LICENSE file.
+
+package priority_queue
+
+import (
+	"math/rand"
+	"sort"
+	"testing"
+)
+
+var _ Interface[int] = NewStablePriorityQueue[int]()
+
+func TestStablePriorityQueue(t *testing.T) {
+	type job struct {
+		priority int
+		id       int
+	}
+
+	rnd := rand.New(rand.NewSource(42))
+	pq := NewStablePriorityQueueWithComparatorFunc(func(lhs, rhs job) bool { return lhs.priority < rhs.priority })
+	jobs := make([]job, 1000)
+
+	for i := range jobs {
+		jobs[i] = job{rnd.Intn(5), i}
+		pq.Push(jobs[i])
+	}
+
+	// higher priority first, ties in insertion order
+	sort.SliceStable(jobs, func(i, j int) bool { return jobs[i].priority > jobs[j].priority })
+
+	for _, want := range jobs {
+		if got := pq.Pop(); got != want {
+			t.Fatalf("Pop() = %v, want %v", got, want)
+		}
+	}
+}
@@ -0,0 +1,179 @@
+// Copyright 2021. The GTL Authors. All rights reserved.
+// https://github.com/modern-dev/gtl
+// Use of this source code is governed by the MIT
+// license that can be found in the LICENSE file.
+
+package priority_queue
+
+import (
+	"constraints"
+	"sync"
+
+	"github.com/modern-dev/gtl/utility"
+)
+
+type (
+	// TopK collects the k largest (by default) elements of a stream.
+	// It keeps the collected elements in a PriorityQueue with the reversed ordering,
+	// so the worst of them is always on top and can be replaced in logarithmic time.
+	TopK[T any] struct {
+		pq      *PriorityQueue[T]
+		k       int
+		cmpInst utility.Compare[T]
+	}
+
+	// ShardedTopK collects the k largest (by default) elements of a stream produced by several goroutines.
+	// Every goroutine pushes into its own shard without synchronization, and the shards are merged on Drain.
+	ShardedTopK[T any] struct {
+		mu      sync.Mutex
+		shards  []*TopK[T]
+		k       int
+		cmpInst utility.Compare[T]
+	}
+)
+
+// NewTopK constructs the TopK collecting at most k elements.
+func NewTopK[T constraints.Ordered](k int) *TopK[T] {
+	return NewTopKWithComparator[T](k, &utility.Less[T]{})
+}
+
+// NewTopKWithComparator constructs the TopK collecting at most k elements.
+// A utility.Compare type providing a strict weak ordering, the largest elements are collected.
+func NewTopKWithComparator[T any](k int, comparator utility.Compare[T]) *TopK[T] {
+	reversed := utility.CompareFunc[T](func(lhs, rhs T) bool {
+		return comparator.Cmp(rhs, lhs)
+	})
+
+	return &TopK[T]{
+		pq:      NewPriorityQueueWithComparator[T](reversed),
+		k:       k,
+		cmpInst: comparator,
+	}
+}
+
+// NewTopKWithComparatorFunc constructs the TopK collecting at most k elements.
+// A less function providing a strict weak ordering, the largest elements are collected, see utility.CompareFunc.
+func NewTopKWithComparatorFunc[T any](k int, less func(lhs, rhs T) bool) *TopK[T] {
+	return NewTopKWithComparator[T](k, utility.CompareFunc[T](less))
+}
+
+// NewTopKWithOrdering constructs the TopK collecting at most k elements.
+// A utility.Ordering three-way comparator providing a strict weak ordering, the largest elements are collected.
+func NewTopKWithOrdering[T any](k int, ordering utility.Ordering[T]) *TopK[T] {
+	return NewTopKWithComparator[T](k, utility.ToCompare(ordering))
+}
+
+// Size returns the number of collected elements.
+// Complexity - constant.
+func (c *TopK[T]) Size() int {
+	return c.pq.Size()
+}
+
+// Capacity returns the maximum number of collected elements.
+// Complexity - constant.
+func (c *TopK[T]) Capacity() int {
+	return c.k
+}
+
+// Push offers the given element value to the TopK.
+// The element is kept if fewer than k elements were collected or if it is larger than the worst of them.
+// Returns true if the element was kept.
+// Complexity - logarithmic in k.
+func (c *TopK[T]) Push(value T) bool {
+	if c.pq.Size() < c.k {
+		c.pq.Push(value)
+
+		return true
+	}
+
+	if c.k <= 0 || !c.cmpInst.Cmp(c.pq.Top(), value) {
+		return false
+	}
+
+	c.pq.Pop()
+	c.pq.Push(value)
+
+	return true
+}
+
+// Merge offers all elements collected by other to the TopK, other is left empty.
+// Complexity - O(m log k), where m is the number of elements in other.
+func (c *TopK[T]) Merge(other *TopK[T]) {
+	if c == other {
+		return
+	}
+
+	for !other.pq.Empty() {
+		c.Push(other.pq.Pop())
+	}
+}
+
+// Drain removes and returns the collected elements sorted from the largest to the smallest.
+// Complexity - O(k log k).
+func (c *TopK[T]) Drain() []T {
+	res := make([]T, c.pq.Size())
+
+	for i := len(res) - 1; i >= 0; i-- {
+		res[i] = c.pq.Pop()
+	}
+
+	return res
+}
+
+// NewShardedTopK constructs the ShardedTopK collecting at most k elements.
+func NewShardedTopK[T constraints.Ordered](k int) *ShardedTopK[T] {
+	return NewShardedTopKWithComparator[T](k, &utility.Less[T]{})
+}
+
+// NewShardedTopKWithComparator constructs the ShardedTopK collecting at most k elements.
+// A utility.Compare type providing a strict weak ordering, the largest elements are collected.
+func NewShardedTopKWithComparator[T any](k int, comparator utility.Compare[T]) *ShardedTopK[T] {
+	return &ShardedTopK[T]{
+		k:       k,
+		cmpInst: comparator,
+	}
+}
+
+// NewShardedTopKWithComparatorFunc constructs the ShardedTopK collecting at most k elements.
+// A less function providing a strict weak ordering, the largest elements are collected, see utility.CompareFunc.
+func NewShardedTopKWithComparatorFunc[T any](k int, less func(lhs, rhs T) bool) *ShardedTopK[T] {
+	return NewShardedTopKWithComparator[T](k, utility.CompareFunc[T](less))
+}
+
+// NewShardedTopKWithOrdering constructs the ShardedTopK collecting at most k elements.
+// A utility.Ordering three-way comparator providing a strict weak ordering, the largest elements are collected.
+func NewShardedTopKWithOrdering[T any](k int, ordering utility.Ordering[T]) *ShardedTopK[T] {
+	return NewShardedTopKWithComparator[T](k, utility.ToCompare(ordering))
+}
+
+// NewShard returns a new TopK whose elements are merged into the result of Drain.
+// NewShard is safe for concurrent use, while the returned shard must be used by a single goroutine at a time.
+func (s *ShardedTopK[T]) NewShard() *TopK[T] {
+	shard := NewTopKWithComparator[T](s.k, s.cmpInst)
+
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	s.shards = append(s.shards, shard)
+
+	return shard
+}
+
+// Drain merges all shards and returns the collected elements sorted from the largest to the smallest.
+// Drain has to be called once all goroutines have stopped pushing into the shards.
+// The shards are left empty and detached from the ShardedTopK.
+// Complexity - O(n log k), where n is the total number of elements in the shards.
+func (s *ShardedTopK[T]) Drain() []T {
+	s.mu.Lock()
+	shards := s.shards
+	s.shards = nil
+	s.mu.Unlock()
+
+	res := NewTopKWithComparator[T](s.k, s.cmpInst)
+
+	for _, shard := range shards {
+		res.Merge(shard)
+	}
+
+	return res.Drain()
+}
@@ -0,0 +1,106 @@
+// Copyright 2021. The GTL Authors. All rights reserved.
+// https://github.com/modern-dev/gtl
+// Use of this source code is governed by the MIT
+// license that can be found in the LICENSE file.
+
+package priority_queue
+
+import (
+	"math/rand"
+	"sort"
+	"sync"
+	"testing"
+
+	"github.com/modern-dev/gtl/utility"
+)
+
+func TestTopK(t *testing.T) {
+	c := NewTopK[int](3)
+
+	for _, v := range []int{5, 1, 9, 3, 7, 9, 2} {
+		c.Push(v)
+	}
+
+	if c.Size() != 3 {
+		t.Errorf("Expected TopK size to be {%d}, got {%d} instead", 3, c.Size())
+	}
+
+	checkSlice(c.Drain(), []int{9, 9, 7}, t)
+
+	if c.Size() != 0 {
+		t.Errorf("Expected TopK to be empty after Drain(), got size {%d}", c.Size())
+	}
+}
+
+func TestTopKWithOrdering(t *testing.T) {
+	c := NewTopKWithOrdering[string](2, utility.CaseInsensitive())
+	byLength := NewTopKWithComparatorFunc(2, func(lhs, rhs string) bool { return len(lhs) < len(rhs) })
+
+	for _, v := range []string{"delta", "Alpha", "Echo", "bravo"} {
+		c.Push(v)
+		byLength.Push(v)
+	}
+
+	checkSlice(c.Drain(), []string{"Echo", "delta"}, t)
+
+	if got := byLength.Drain(); len(got) != 2 || len(got[0]) != 5 || len(got[1]) != 5 {
+		t.Errorf("Expected to get two longest words, got %v", got)
+	}
+
+	sharded := NewShardedTopKWithOrdering[string](1, utility.CaseInsensitive())
+	sharded.NewShard().Push("alpha")
+	sharded.NewShard().Push("Bravo")
+
+	checkSlice(sharded.Drain(), []string{"Bravo"}, t)
+}
+
+func TestTopKWithComparator(t *testing.T) {
+	c := NewTopKWithComparator[string](2, &utility.Greater[string]{})
+
+	for _, v := range []string{"pear", "apple", "fig", "banana"} {
+		c.Push(v)
+	}
+
+	checkSlice(c.Drain(), []string{"apple", "banana"}, t)
+}
+
+func TestShardedTopK(t *testing.T) {
+	const (
+		k          = 10
+		goroutines = 8
+	)
+
+	items := rand.New(rand.NewSource(42)).Perm(10000)
+	s := NewShardedTopK[int](k)
+
+	var wg sync.WaitGroup
+
+	for g := 0; g < goroutines; g++ {
+		wg.Add(1)
+
+		go func(shard *TopK[int], g int) {
+			defer wg.Done()
+
+			for i := g; i < len(items); i += goroutines {
+				shard.Push(items[i])
+			}
+		}(s.NewShard(), g)
+	}
+
+	wg.Wait()
+
+	sort.Sort(sort.Reverse(sort.IntSlice(items)))
+	checkSlice(s.Drain(), items[:k], t)
+}
+
+func checkSlice[T comparable](got, want []T, t *testing.T) {
+	if len(got) != len(want) {
+		t.Fatalf("Expected %v, got %v", want, got)
+	}
+
+	for i := range want {
+		if got[i] != want[i] {
+			t.Fatalf("Expected %v, got %v", want, got)
+		}
+	}
+}
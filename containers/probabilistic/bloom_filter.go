@@ -0,0 +1,146 @@
+// Copyright 2021. The GTL Authors. All rights reserved.
+// https://github.com/modern-dev/gtl
+// Use of this source code is governed by the MIT
+// license that can be found in the LICENSE file.
+
+package probabilistic
+
+import (
+	"math"
+	"math/bits"
+
+	"github.com/modern-dev/gtl/utility"
+)
+
+// BloomFilter is a set that answers membership queries with no false negatives
+// and a bounded rate of false positives, using a few bits per element regardless of the element size.
+// Elements cannot be removed, see CountingBloomFilter.
+type BloomFilter[T any] struct {
+	bits   []uint64
+	m      uint64
+	k      int
+	n      int
+	hasher utility.Hasher[T]
+}
+
+// NewBloomFilter creates an empty BloomFilter sized to hold capacity elements
+// with the false positive rate fpr. Panics if capacity is not positive or fpr is not in (0, 1).
+func NewBloomFilter[T any](capacity int, fpr float64, hasher utility.Hasher[T]) *BloomFilter[T] {
+	m, k := optimalBloomSize(capacity, fpr)
+
+	return NewBloomFilterWithSize[T](m, k, hasher)
+}
+
+// NewBloomFilterWithSize creates an empty BloomFilter with m bits and k hash functions.
+// Panics if m or k is not positive.
+func NewBloomFilterWithSize[T any](m uint64, k int, hasher utility.Hasher[T]) *BloomFilter[T] {
+	if m == 0 || k <= 0 {
+		panic("probabilistic: number of bits and hash functions must be positive")
+	}
+
+	return &BloomFilter[T]{
+		bits:   make([]uint64, (m+63)/64),
+		m:      m,
+		k:      k,
+		hasher: hasher,
+	}
+}
+
+// Add inserts the element into the filter.
+// Complexity - O(k), where k is the number of hash functions.
+func (f *BloomFilter[T]) Add(item T) {
+	p := newProbes(f.hasher.Hash(item))
+
+	for i := 0; i < f.k; i++ {
+		pos := p.at(i, f.m)
+		f.bits[pos/64] |= 1 << (pos % 64)
+	}
+
+	f.n++
+}
+
+// Contains checks if the element may have been inserted.
+// Returns false only if it definitely has not been.
+// Complexity - O(k), where k is the number of hash functions.
+func (f *BloomFilter[T]) Contains(item T) bool {
+	p := newProbes(f.hasher.Hash(item))
+
+	for i := 0; i < f.k; i++ {
+		if pos := p.at(i, f.m); f.bits[pos/64]&(1<<(pos%64)) == 0 {
+			return false
+		}
+	}
+
+	return true
+}
+
+// Count returns the number of Add calls, including the repeated elements.
+// Complexity - O(1).
+func (f *BloomFilter[T]) Count() int {
+	return f.n
+}
+
+// Bits returns the number of bits of the filter.
+// Complexity - O(1).
+func (f *BloomFilter[T]) Bits() uint64 {
+	return f.m
+}
+
+// Hashes returns the number of hash functions of the filter.
+// Complexity - O(1).
+func (f *BloomFilter[T]) Hashes() int {
+	return f.k
+}
+
+// FillRatio returns the fraction of the bits that are set.
+// Complexity - O(m), where m is the number of bits.
+func (f *BloomFilter[T]) FillRatio() float64 {
+	set := 0
+
+	for _, word := range f.bits {
+		set += bits.OnesCount64(word)
+	}
+
+	return float64(set) / float64(f.m)
+}
+
+// EstimatedFPR returns the expected false positive rate for the current number of elements.
+// Complexity - O(1).
+func (f *BloomFilter[T]) EstimatedFPR() float64 {
+	return math.Pow(1-math.Exp(-float64(f.k)*float64(f.n)/float64(f.m)), float64(f.k))
+}
+
+// Merge adds all elements of the other filter into this one.
+// Returns ErrIncompatible if the filters have different numbers of bits or hash functions.
+// Both filters must use the same hasher.
+// Complexity - O(m), where m is the number of bits.
+func (f *BloomFilter[T]) Merge(other *BloomFilter[T]) error {
+	if f.m != other.m || f.k != other.k {
+		return ErrIncompatible
+	}
+
+	for i, word := range other.bits {
+		f.bits[i] |= word
+	}
+
+	f.n += other.n
+
+	return nil
+}
+
+// Clear removes all elements from the filter.
+// Complexity - O(m), where m is the number of bits.
+func (f *BloomFilter[T]) Clear() {
+	for i := range f.bits {
+		f.bits[i] = 0
+	}
+
+	f.n = 0
+}
+
+func (f *BloomFilter[T]) clone() *BloomFilter[T] {
+	res := *f
+	res.bits = append([]uint64{}, f.bits...)
+
+	return &res
+}
@@ -0,0 +1,312 @@
+// Copyright 2021. The GTL Authors. All rights reserved.
+// https://github.com/modern-dev/gtl
+// Use of this source code is governed by the MIT
+// license that can be found in the LICENSE file.
+
+package probabilistic
+
+import (
+	"testing"
+
+	"github.com/modern-dev/gtl/utility"
+)
+
+// falsePositives counts the elements of [from, to) reported as members of the filter.
+func falsePositives(contains func(int) bool, from, to int) int {
+	res := 0
+
+	for i := from; i < to; i++ {
+		if contains(i) {
+			res++
+		}
+	}
+
+	return res
+}
+
+func TestBloomFilter(t *testing.T) {
+	f := NewBloomFilter[int](1000, 0.01, utility.IntegerHasher[int]())
+
+	if f.Bits() != 9586 || f.Hashes() != 7 {
+		t.Errorf("Expected to get (9586, 7), got (%d, %d)", f.Bits(), f.Hashes())
+	}
+
+	for i := 0; i < 1000; i++ {
+		f.Add(i)
+	}
+
+	for i := 0; i < 1000; i++ {
+		if !f.Contains(i) {
+			t.Fatalf("Expected %d to be in the filter", i)
+		}
+	}
+
+	if fp := falsePositives(f.Contains, 1000, 11000); fp > 200 {
+		t.Errorf("Expected at most 200 false positives, got %d", fp)
+	}
+
+	if f.Count() != 1000 {
+		t.Errorf("Expected to get 1000, got %d", f.Count())
+	}
+
+	if fpr := f.EstimatedFPR(); fpr < 0.005 || fpr > 0.015 {
+		t.Errorf("Expected to get about 0.01, got %f", fpr)
+	}
+
+	if fill := f.FillRatio(); fill < 0.45 || fill > 0.55 {
+		t.Errorf("Expected to get about 0.5, got %f", fill)
+	}
+
+	f.Clear()
+
+	if f.Contains(1) || f.Count() != 0 || f.FillRatio() != 0 {
+		t.Errorf("Expected the filter to be empty")
+	}
+}
+
+func TestBloomFilterMerge(t *testing.T) {
+	hasher := utility.StringHasher()
+	lhs := NewBloomFilter[string](100, 0.01, hasher)
+	rhs := NewBloomFilter[string](100, 0.01, hasher)
+
+	lhs.Add("foo")
+	rhs.Add("bar")
+
+	if err := lhs.Merge(rhs); err != nil {
+		t.Fatalf("Merge() failed: %v", err)
+	}
+
+	if !lhs.Contains("foo") || !lhs.Contains("bar") || lhs.Count() != 2 {
+		t.Errorf("Expected the merged filter to hold both elements")
+	}
+
+	if err := lhs.Merge(NewBloomFilter[string](200, 0.01, hasher)); err != ErrIncompatible {
+		t.Errorf("Expected to get %v, got %v", ErrIncompatible, err)
+	}
+}
+
+func TestBloomFilterEncoding(t *testing.T) {
+	hasher := utility.IntegerHasher[int]()
+	f := NewBloomFilter[int](100, 0.01, hasher)
+
+	for i := 0; i < 100; i += 3 {
+		f.Add(i)
+	}
+
+	bin, err := f.MarshalBinary()
+	if err != nil {
+		t.Fatalf("MarshalBinary() failed: %v", err)
+	}
+
+	js, err := f.MarshalJSON()
+	if err != nil {
+		t.Fatalf("MarshalJSON() failed: %v", err)
+	}
+
+	// the size is taken from the data
+	fromBin := NewBloomFilterWithSize[int](1, 1, hasher)
+	fromJSON := NewBloomFilterWithSize[int](1, 1, hasher)
+
+	if err := fromBin.UnmarshalBinary(bin); err != nil {
+		t.Fatalf("UnmarshalBinary() failed: %v", err)
+	}
+
+	if err := fromJSON.UnmarshalJSON(js); err != nil {
+		t.Fatalf("UnmarshalJSON() failed: %v", err)
+	}
+
+	for _, g := range []*BloomFilter[int]{fromBin, fromJSON} {
+		if g.Bits() != f.Bits() || g.Hashes() != f.Hashes() || g.Count() != f.Count() {
+			t.Errorf("Expected to get (%d, %d, %d), got (%d, %d, %d)",
+				f.Bits(), f.Hashes(), f.Count(), g.Bits(), g.Hashes(), g.Count())
+		}
+
+		for i := 0; i < 100; i++ {
+			if g.Contains(i) != f.Contains(i) {
+				t.Errorf("Expected Contains(%d) to be %t", i, f.Contains(i))
+			}
+		}
+	}
+
+	var zero BloomFilter[int]
+
+	if err := zero.UnmarshalBinary(bin); err != ErrNoHasher {
+		t.Errorf("Expected to get %v, got %v", ErrNoHasher, err)
+	}
+
+	if err := fromJSON.UnmarshalJSON([]byte(`{"Bits":[],"M":64,"K":1}`)); err != ErrCorrupted {
+		t.Errorf("Expected to get %v, got %v", ErrCorrupted, err)
+	}
+}
+
+func TestScalableBloomFilter(t *testing.T) {
+	f := NewScalableBloomFilter[int](100, 0.01, utility.IntegerHasher[int]())
+
+	for i := 0; i < 3000; i++ {
+		f.Add(i)
+	}
+
+	// 100 + 200 + 400 + 800 + 1600 >= 3000
+	if f.Stages() != 5 {
+		t.Errorf("Expected to get 5, got %d", f.Stages())
+	}
+
+	for i := 0; i < 3000; i++ {
+		if !f.Contains(i) {
+			t.Fatalf("Expected %d to be in the filter", i)
+		}
+	}
+
+	if fp := falsePositives(f.Contains, 3000, 23000); fp > 300 {
+		t.Errorf("Expected at most 300 false positives, got %d", fp)
+	}
+
+	count := f.Count()
+	f.Add(0)
+
+	if f.Count() != count {
+		t.Errorf("Expected the present element not to be added again")
+	}
+
+	f.Clear()
+
+	if f.Stages() != 1 || f.Count() != 0 || f.Contains(1) {
+		t.Errorf("Expected the filter to be empty")
+	}
+}
+
+func TestScalableBloomFilterMerge(t *testing.T) {
+	hasher := utility.IntegerHasher[int]()
+	lhs := NewScalableBloomFilter[int](10, 0.01, hasher)
+	rhs := NewScalableBloomFilter[int](10, 0.01, hasher)
+
+	for i := 0; i < 50; i++ {
+		rhs.Add(i)
+	}
+
+	lhs.Add(100)
+
+	if err := lhs.Merge(rhs); err != nil {
+		t.Fatalf("Merge() failed: %v", err)
+	}
+
+	// the full first stage of rhs does not fit into the first stage of lhs, so all stages of rhs are added
+	if lhs.Stages() != rhs.Stages()+1 {
+		t.Errorf("Expected to get %d, got %d", rhs.Stages()+1, lhs.Stages())
+	}
+
+	sparse := NewScalableBloomFilter[int](10, 0.01, hasher)
+	sparse.Add(200)
+
+	if err := lhs.Merge(sparse); err != nil || lhs.Stages() != rhs.Stages()+1 {
+		t.Errorf("Expected the stage that fits to be merged in place, got %d stages", lhs.Stages())
+	}
+
+	for _, x := range []int{0, 25, 49, 100, 200} {
+		if !lhs.Contains(x) {
+			t.Errorf("Expected %d to be in the filter", x)
+		}
+	}
+
+	if err := lhs.Merge(NewScalableBloomFilter[int](10, 0.1, hasher)); err != ErrIncompatible {
+		t.Errorf("Expected to get %v, got %v", ErrIncompatible, err)
+	}
+}
+
+func TestScalableBloomFilterMergeFPR(t *testing.T) {
+	hasher := utility.IntegerHasher[int]()
+	lhs := NewScalableBloomFilter[int](1000, 0.01, hasher)
+	rhs := NewScalableBloomFilter[int](1000, 0.01, hasher)
+
+	// both filters have three full stages
+	for i := 0; i < 7000; i++ {
+		lhs.Add(i)
+		rhs.Add(i + 1000000)
+	}
+
+	if err := lhs.Merge(rhs); err != nil {
+		t.Fatalf("Merge() failed: %v", err)
+	}
+
+	// the rate stays under the sum of the targets, 0.02, with some margin for noise
+	if fp := falsePositives(lhs.Contains, 2000000, 2200000); fp > 5000 {
+		t.Errorf("Expected at most 5000 false positives, got %d", fp)
+	}
+
+	lhs.Add(3000000)
+
+	if !lhs.Contains(3000000) || !lhs.Contains(1006999) {
+		t.Errorf("Expected the merged filter to keep growing")
+	}
+
+	// the added stages keep their sizes through encoding
+	bin, err := lhs.MarshalBinary()
+	if err != nil {
+		t.Fatalf("MarshalBinary() failed: %v", err)
+	}
+
+	decoded := NewScalableBloomFilter[int](1, 0.5, hasher)
+
+	if err := decoded.UnmarshalBinary(bin); err != nil {
+		t.Fatalf("UnmarshalBinary() failed: %v", err)
+	}
+
+	if decoded.Stages() != lhs.Stages() || decoded.Count() != lhs.Count() || !decoded.Contains(1006999) {
+		t.Errorf("Expected to get (%d, %d), got (%d, %d)", lhs.Stages(), lhs.Count(), decoded.Stages(), decoded.Count())
+	}
+}
+
+func TestScalableBloomFilterEncoding(t *testing.T) {
+	hasher := utility.IntegerHasher[int]()
+	f := NewScalableBloomFilter[int](10, 0.01, hasher)
+
+	for i := 0; i < 50; i++ {
+		f.Add(i * 7)
+	}
+
+	bin, err := f.MarshalBinary()
+	if err != nil {
+		t.Fatalf("MarshalBinary() failed: %v", err)
+	}
+
+	js, err := f.MarshalJSON()
+	if err != nil {
+		t.Fatalf("MarshalJSON() failed: %v", err)
+	}
+
+	fromBin := NewScalableBloomFilter[int](1, 0.5, hasher)
+	fromJSON := NewScalableBloomFilter[int](1, 0.5, hasher)
+
+	if err := fromBin.UnmarshalBinary(bin); err != nil {
+		t.Fatalf("UnmarshalBinary() failed: %v", err)
+	}
+
+	if err := fromJSON.UnmarshalJSON(js); err != nil {
+		t.Fatalf("UnmarshalJSON() failed: %v", err)
+	}
+
+	for _, g := range []*ScalableBloomFilter[int]{fromBin, fromJSON} {
+		if g.Stages() != f.Stages() || g.Count() != f.Count() {
+			t.Errorf("Expected to get (%d, %d), got (%d, %d)", f.Stages(), f.Count(), g.Stages(), g.Count())
+		}
+
+		for i := 0; i < 350; i++ {
+			if g.Contains(i) != f.Contains(i) {
+				t.Errorf("Expected Contains(%d) to be %t", i, f.Contains(i))
+			}
+		}
+
+		// the decoded filter keeps growing as the original one
+		g.Add(1000)
+
+		if !g.Contains(1000) {
+			t.Errorf("Expected 1000 to be in the filter")
+		}
+	}
+
+	var zero ScalableBloomFilter[int]
+
+	if err := zero.UnmarshalJSON(js); err != ErrNoHasher {
+		t.Errorf("Expected to get %v, got %v", ErrNoHasher, err)
+	}
+}
@@ -0,0 +1,245 @@
+// Copyright 2021. The GTL Authors. All rights reserved.
+// https://github.com/modern-dev/gtl
+// Use of this source code is governed by the MIT
+// license that can be found in the LICENSE file.
+
+package probabilistic
+
+import (
+	"math"
+	"sort"
+
+	"github.com/modern-dev/gtl/containers/hash_map"
+	"github.com/modern-dev/gtl/containers/priority_queue"
+	"github.com/modern-dev/gtl/utility"
+)
+
+type (
+	// CountMinSketch estimates the frequencies of the elements of a stream in sublinear space,
+	// see Cormode and Muthukrishnan, "An Improved Data Stream Summary: The Count-Min Sketch and its Applications".
+	// The estimates are never below the true frequencies and exceed them by at most epsilon * Total
+	// with the probability of at least 1 - delta.
+	// Optionally, the sketch tracks the k most frequent elements seen so far, see HeavyHitters.
+	CountMinSketch[T any] struct {
+		counts []uint64
+		width  int
+		depth  int
+		total  uint64
+		hasher utility.Hasher[T]
+		topK   int
+		heavy  *hash_map.HashMap[T, uint64]
+		// minHeavy keeps the tracked elements with the smallest estimate on top.
+		// It may hold stale entries of the elements that were evicted or whose estimate has grown,
+		// they are skipped lazily.
+		minHeavy *priority_queue.PriorityQueue[HeavyHitter[T]]
+	}
+
+	// HeavyHitter is an element tracked by a CountMinSketch along with its estimated frequency.
+	HeavyHitter[T any] struct {
+		Item  T
+		Count uint64
+	}
+)
+
+// NewCountMinSketch creates an empty CountMinSketch whose estimates exceed the true frequencies
+// by at most epsilon * Total with the probability of at least 1 - delta.
+// Panics if epsilon or delta is not in (0, 1).
+func NewCountMinSketch[T any](epsilon, delta float64, hasher utility.Hasher[T]) *CountMinSketch[T] {
+	return NewCountMinSketchWithHeavyHitters[T](epsilon, delta, 0, hasher)
+}
+
+// NewCountMinSketchWithHeavyHitters creates an empty CountMinSketch that also tracks the k most frequent elements.
+// Panics if epsilon or delta is not in (0, 1) or k is negative.
+func NewCountMinSketchWithHeavyHitters[T any](epsilon, delta float64, k int, hasher utility.Hasher[T]) *CountMinSketch[T] {
+	if epsilon <= 0 || epsilon >= 1 || delta <= 0 || delta >= 1 {
+		panic("probabilistic: epsilon and delta must be in (0, 1)")
+	}
+
+	width := int(math.Ceil(math.E / epsilon))
+	depth := int(math.Ceil(math.Log(1 / delta)))
+
+	return newCountMinSketch(width, depth, k, hasher)
+}
+
+func newCountMinSketch[T any](width, depth, k int, hasher utility.Hasher[T]) *CountMinSketch[T] {
+	if k < 0 {
+		panic("probabilistic: number of heavy hitters must not be negative")
+	}
+
+	return &CountMinSketch[T]{
+		counts:   make([]uint64, width*depth),
+		width:    width,
+		depth:    depth,
+		hasher:   hasher,
+		topK:     k,
+		heavy:    hash_map.NewHashMap[T, uint64](hasher),
+		minHeavy: newMinHeavy[T](nil),
+	}
+}
+
+// Add increases the frequency of the element by count.
+// Complexity - O(d + log k), where d is the depth of the sketch and k is the number of tracked heavy hitters.
+func (s *CountMinSketch[T]) Add(item T, count uint64) {
+	p := newProbes(s.hasher.Hash(item))
+
+	for row := 0; row < s.depth; row++ {
+		s.counts[row*s.width+int(p.at(row, uint64(s.width)))] += count
+	}
+
+	s.total += count
+
+	if s.topK > 0 {
+		s.track(item)
+	}
+}
+
+// Estimate returns the estimated frequency of the element.
+// Complexity - O(d), where d is the depth of the sketch.
+func (s *CountMinSketch[T]) Estimate(item T) uint64 {
+	p := newProbes(s.hasher.Hash(item))
+	est := uint64(math.MaxUint64)
+
+	for row := 0; row < s.depth; row++ {
+		if c := s.counts[row*s.width+int(p.at(row, uint64(s.width)))]; c < est {
+			est = c
+		}
+	}
+
+	return est
+}
+
+// Total returns the sum of the counts of all Add calls.
+// Complexity - O(1).
+func (s *CountMinSketch[T]) Total() uint64 {
+	return s.total
+}
+
+// Width returns the number of counters in each row of the sketch.
+// Complexity - O(1).
+func (s *CountMinSketch[T]) Width() int {
+	return s.width
+}
+
+// Depth returns the number of rows of the sketch.
+// Complexity - O(1).
+func (s *CountMinSketch[T]) Depth() int {
+	return s.depth
+}
+
+// HeavyHitters returns the tracked most frequent elements ordered by the estimated frequency, the largest first.
+// Returns an empty slice if the sketch was created without heavy hitter tracking.
+// Complexity - O(k * log k), where k is the number of tracked heavy hitters.
+func (s *CountMinSketch[T]) HeavyHitters() []HeavyHitter[T] {
+	res := make([]HeavyHitter[T], 0, s.heavy.Size())
+
+	s.heavy.Each(func(item T, count uint64) bool {
+		res = append(res, HeavyHitter[T]{item, count})
+
+		return true
+	})
+
+	sort.SliceStable(res, func(i, j int) bool {
+		return res[i].Count > res[j].Count
+	})
+
+	return res
+}
+
+// Merge adds all counts of the other sketch into this one.
+// The heavy hitters of both sketches are re-estimated against the merged counters and the k most frequent are kept.
+// Returns ErrIncompatible if the sketches have different sizes or numbers of tracked heavy hitters.
+// Both sketches must use the same hasher.
+// Complexity - O(w * d + k * (d + log k)), where w and d are the sizes of the sketch
+// and k is the number of tracked heavy hitters.
+func (s *CountMinSketch[T]) Merge(other *CountMinSketch[T]) error {
+	if s.width != other.width || s.depth != other.depth || s.topK != other.topK {
+		return ErrIncompatible
+	}
+
+	for i, c := range other.counts {
+		s.counts[i] += c
+	}
+
+	s.total += other.total
+
+	candidates := append(s.HeavyHitters(), other.HeavyHitters()...)
+	s.resetHeavy()
+
+	for _, h := range candidates {
+		s.track(h.Item)
+	}
+
+	return nil
+}
+
+// Clear resets all counters and forgets the heavy hitters.
+// Complexity - O(w * d), where w and d are the sizes of the sketch.
+func (s *CountMinSketch[T]) Clear() {
+	for i := range s.counts {
+		s.counts[i] = 0
+	}
+
+	s.total = 0
+	s.resetHeavy()
+}
+
+// track updates the estimate of the element among the heavy hitters,
+// replacing the least frequent one if the element is not tracked yet.
+func (s *CountMinSketch[T]) track(item T) {
+	est := s.Estimate(item)
+
+	if old, ok := s.heavy.Get(item); ok {
+		if old != est {
+			s.push(item, est)
+		}
+
+		return
+	}
+
+	if s.heavy.Size() < s.topK {
+		s.push(item, est)
+
+		return
+	}
+
+	if least := s.least(); est > least.Count {
+		s.minHeavy.Pop()
+		s.heavy.Erase(least.Item)
+		s.push(item, est)
+	}
+}
+
+func (s *CountMinSketch[T]) push(item T, est uint64) {
+	s.heavy.Insert(item, est)
+	s.minHeavy.Push(HeavyHitter[T]{item, est})
+
+	// drop the stale entries once they outnumber the tracked elements
+	if s.minHeavy.Size() > 2*s.topK+16 {
+		s.minHeavy = newMinHeavy(s.HeavyHitters())
+	}
+}
+
+// least returns the tracked element with the smallest estimate, discarding the stale entries on top of the queue.
+func (s *CountMinSketch[T]) least() HeavyHitter[T] {
+	for {
+		top := s.minHeavy.Top()
+
+		if count, ok := s.heavy.Get(top.Item); ok && count == top.Count {
+			return top
+		}
+
+		s.minHeavy.Pop()
+	}
+}
+
+func (s *CountMinSketch[T]) resetHeavy() {
+	s.heavy.Clear()
+	s.minHeavy = newMinHeavy[T](nil)
+}
+
+func newMinHeavy[T any](items []HeavyHitter[T]) *priority_queue.PriorityQueue[HeavyHitter[T]] {
+	return priority_queue.NewPriorityQueueFromWithComparator[HeavyHitter[T]](items, utility.CompareFunc[HeavyHitter[T]](
+		func(lhs, rhs HeavyHitter[T]) bool {
+			return lhs.Count > rhs.Count
+		}))
+}
@@ -0,0 +1,160 @@
+// Copyright 2021. The GTL Authors. All rights reserved.
+// https://github.com/modern-dev/gtl
+// Use of this source code is governed by the MIT
+// license that can be found in the LICENSE file.
+
+package probabilistic
+
+import (
+	"reflect"
+	"testing"
+
+	"github.com/modern-dev/gtl/utility"
+)
+
+// addZipf adds the element i with the frequency n / i for every i in [1, n].
+func addZipf(s *CountMinSketch[int], n int) {
+	for i := 1; i <= n; i++ {
+		s.Add(i, uint64(n/i))
+	}
+}
+
+func TestCountMinSketch(t *testing.T) {
+	s := NewCountMinSketch[int](0.001, 0.01, utility.IntegerHasher[int]())
+
+	if s.Width() != 2719 || s.Depth() != 5 {
+		t.Errorf("Expected to get (2719, 5), got (%d, %d)", s.Width(), s.Depth())
+	}
+
+	freq := map[int]uint64{}
+
+	for i := 0; i < 10000; i++ {
+		x := i * i % 1009
+		freq[x]++
+		s.Add(x, 1)
+	}
+
+	if s.Total() != 10000 {
+		t.Errorf("Expected to get 10000, got %d", s.Total())
+	}
+
+	for x, want := range freq {
+		// the error is at most epsilon * Total with high probability
+		if got := s.Estimate(x); got < want || got > want+10 {
+			t.Errorf("Expected Estimate(%d) to be in [%d, %d], got %d", x, want, want+10, got)
+		}
+	}
+
+	if len(s.HeavyHitters()) != 0 {
+		t.Errorf("Expected no heavy hitters to be tracked")
+	}
+
+	s.Clear()
+
+	if s.Total() != 0 || s.Estimate(1) != 0 {
+		t.Errorf("Expected the sketch to be empty")
+	}
+}
+
+func TestCountMinSketchHeavyHitters(t *testing.T) {
+	s := NewCountMinSketchWithHeavyHitters[int](0.001, 0.01, 3, utility.IntegerHasher[int]())
+
+	for i := 0; i < 100; i++ {
+		addZipf(s, 100)
+	}
+
+	want := []HeavyHitter[int]{{1, 10000}, {2, 5000}, {3, 3300}}
+
+	if got := s.HeavyHitters(); !reflect.DeepEqual(got, want) {
+		t.Errorf("Expected to get %v, got %v", want, got)
+	}
+
+	// a late but frequent element displaces the least frequent one
+	s.Add(42, 4000)
+
+	want = []HeavyHitter[int]{{1, 10000}, {2, 5000}, {42, 4200}}
+
+	if got := s.HeavyHitters(); !reflect.DeepEqual(got, want) {
+		t.Errorf("Expected to get %v, got %v", want, got)
+	}
+}
+
+func TestCountMinSketchMerge(t *testing.T) {
+	hasher := utility.IntegerHasher[int]()
+	lhs := NewCountMinSketchWithHeavyHitters[int](0.01, 0.01, 2, hasher)
+	rhs := NewCountMinSketchWithHeavyHitters[int](0.01, 0.01, 2, hasher)
+
+	lhs.Add(1, 10)
+	lhs.Add(2, 8)
+	rhs.Add(3, 7)
+	rhs.Add(2, 5)
+
+	if err := lhs.Merge(rhs); err != nil {
+		t.Fatalf("Merge() failed: %v", err)
+	}
+
+	if lhs.Total() != 30 {
+		t.Errorf("Expected to get 30, got %d", lhs.Total())
+	}
+
+	want := []HeavyHitter[int]{{2, 13}, {1, 10}}
+
+	if got := lhs.HeavyHitters(); !reflect.DeepEqual(got, want) {
+		t.Errorf("Expected to get %v, got %v", want, got)
+	}
+
+	if err := lhs.Merge(NewCountMinSketch[int](0.01, 0.01, hasher)); err != ErrIncompatible {
+		t.Errorf("Expected to get %v, got %v", ErrIncompatible, err)
+	}
+}
+
+func TestCountMinSketchEncoding(t *testing.T) {
+	hasher := utility.StringHasher()
+	s := NewCountMinSketchWithHeavyHitters[string](0.01, 0.01, 2, hasher)
+
+	s.Add("foo", 5)
+	s.Add("bar", 3)
+	s.Add("baz", 1)
+
+	bin, err := s.MarshalBinary()
+	if err != nil {
+		t.Fatalf("MarshalBinary() failed: %v", err)
+	}
+
+	js, err := s.MarshalJSON()
+	if err != nil {
+		t.Fatalf("MarshalJSON() failed: %v", err)
+	}
+
+	fromBin := NewCountMinSketch[string](0.5, 0.5, hasher)
+	fromJSON := NewCountMinSketch[string](0.5, 0.5, hasher)
+
+	if err := fromBin.UnmarshalBinary(bin); err != nil {
+		t.Fatalf("UnmarshalBinary() failed: %v", err)
+	}
+
+	if err := fromJSON.UnmarshalJSON(js); err != nil {
+		t.Fatalf("UnmarshalJSON() failed: %v", err)
+	}
+
+	for _, g := range []*CountMinSketch[string]{fromBin, fromJSON} {
+		if g.Total() != 9 || g.Estimate("foo") != 5 || g.Estimate("baz") != 1 {
+			t.Errorf("Expected to get (9, 5, 1), got (%d, %d, %d)", g.Total(), g.Estimate("foo"), g.Estimate("baz"))
+		}
+
+		// the heavy hitters keep being tracked after decoding
+		g.Add("baz", 9)
+
+		want := []HeavyHitter[string]{{"baz", 10}, {"foo", 5}}
+
+		if got := g.HeavyHitters(); !reflect.DeepEqual(got, want) {
+			t.Errorf("Expected to get %v, got %v", want, got)
+		}
+	}
+
+	var zero CountMinSketch[string]
+
+	if err := zero.UnmarshalJSON(js); err != ErrNoHasher {
+		t.Errorf("Expected to get %v, got %v", ErrNoHasher, err)
+	}
+}
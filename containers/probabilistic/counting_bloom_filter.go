@@ -0,0 +1,127 @@
+// Copyright 2021. The GTL Authors. All rights reserved.
+// https://github.com/modern-dev/gtl
+// Use of this source code is governed by the MIT
+// license that can be found in the LICENSE file.
+
+package probabilistic
+
+import (
+	"math"
+
+	"github.com/modern-dev/gtl/utility"
+)
+
+// CountingBloomFilter is a Bloom filter with small counters instead of bits, so that elements may be removed.
+// The counters saturate at 255 and are never decremented after that,
+// which keeps the filter free of false negatives at the cost of some false positives.
+type CountingBloomFilter[T any] struct {
+	counters []uint8
+	k        int
+	n        int
+	hasher   utility.Hasher[T]
+}
+
+// NewCountingBloomFilter creates an empty CountingBloomFilter sized to hold capacity elements
+// with the false positive rate fpr. Panics if capacity is not positive or fpr is not in (0, 1).
+func NewCountingBloomFilter[T any](capacity int, fpr float64, hasher utility.Hasher[T]) *CountingBloomFilter[T] {
+	m, k := optimalBloomSize(capacity, fpr)
+
+	return &CountingBloomFilter[T]{
+		counters: make([]uint8, m),
+		k:        k,
+		hasher:   hasher,
+	}
+}
+
+// Add inserts the element into the filter.
+// Complexity - O(k), where k is the number of hash functions.
+func (f *CountingBloomFilter[T]) Add(item T) {
+	p := newProbes(f.hasher.Hash(item))
+
+	for i := 0; i < f.k; i++ {
+		if pos := p.at(i, f.size()); f.counters[pos] < math.MaxUint8 {
+			f.counters[pos]++
+		}
+	}
+
+	f.n++
+}
+
+// Remove deletes one copy of the element from the filter and returns true
+// if the element may have been inserted. Removing an element that was not inserted
+// may introduce false negatives, so Remove does nothing if Contains reports false.
+// Complexity - O(k), where k is the number of hash functions.
+func (f *CountingBloomFilter[T]) Remove(item T) bool {
+	if !f.Contains(item) {
+		return false
+	}
+
+	p := newProbes(f.hasher.Hash(item))
+
+	for i := 0; i < f.k; i++ {
+		if pos := p.at(i, f.size()); f.counters[pos] < math.MaxUint8 {
+			f.counters[pos]--
+		}
+	}
+
+	f.n--
+
+	return true
+}
+
+// Contains checks if the element may have been inserted.
+// Returns false only if it definitely has not been.
+// Complexity - O(k), where k is the number of hash functions.
+func (f *CountingBloomFilter[T]) Contains(item T) bool {
+	p := newProbes(f.hasher.Hash(item))
+
+	for i := 0; i < f.k; i++ {
+		if f.counters[p.at(i, f.size())] == 0 {
+			return false
+		}
+	}
+
+	return true
+}
+
+// Count returns the number of elements in the filter.
+// Complexity - O(1).
+func (f *CountingBloomFilter[T]) Count() int {
+	return f.n
+}
+
+// Merge adds all elements of the other filter into this one.
+// Returns ErrIncompatible if the filters have different numbers of counters or hash functions.
+// Both filters must use the same hasher.
+// Complexity - O(m), where m is the number of counters.
+func (f *CountingBloomFilter[T]) Merge(other *CountingBloomFilter[T]) error {
+	if f.size() != other.size() || f.k != other.k {
+		return ErrIncompatible
+	}
+
+	for i, c := range other.counters {
+		if sum := int(f.counters[i]) + int(c); sum < math.MaxUint8 {
+			f.counters[i] = uint8(sum)
+		} else {
+			f.counters[i] = math.MaxUint8
+		}
+	}
+
+	f.n += other.n
+
+	return nil
+}
+
+// Clear removes all elements from the filter.
+// Complexity - O(m), where m is the number of counters.
+func (f *CountingBloomFilter[T]) Clear() {
+	for i := range f.counters {
+		f.counters[i] = 0
+	}
+
+	f.n = 0
+}
+
+func (f *CountingBloomFilter[T]) size() uint64 {
+	return uint64(len(f.counters))
+}
@@ -0,0 +1,151 @@
+// Copyright 2021. The GTL Authors. All rights reserved.
+// https://github.com/modern-dev/gtl
+// Use of this source code is governed by the MIT
+// license that can be found in the LICENSE file.
+
+package probabilistic
+
+import (
+	"testing"
+
+	"github.com/modern-dev/gtl/utility"
+)
+
+func TestCountingBloomFilter(t *testing.T) {
+	f := NewCountingBloomFilter[int](1000, 0.01, utility.IntegerHasher[int]())
+
+	for i := 0; i < 1000; i++ {
+		f.Add(i)
+	}
+
+	for i := 0; i < 1000; i += 2 {
+		if !f.Remove(i) {
+			t.Fatalf("Expected %d to be removed", i)
+		}
+	}
+
+	if f.Count() != 500 {
+		t.Errorf("Expected to get 500, got %d", f.Count())
+	}
+
+	for i := 1; i < 1000; i += 2 {
+		if !f.Contains(i) {
+			t.Fatalf("Expected %d to be in the filter", i)
+		}
+	}
+
+	if fp := falsePositives(func(i int) bool { return f.Contains(2 * i) }, 0, 500); fp > 25 {
+		t.Errorf("Expected at most 25 false positives, got %d", fp)
+	}
+
+	if f.Remove(-1) && f.Contains(-1) {
+		t.Errorf("Expected the absent element not to be removed")
+	}
+
+	// a repeated element stays until all its copies are removed
+	f.Add(5)
+	f.Remove(5)
+
+	if !f.Contains(5) {
+		t.Errorf("Expected 5 to be in the filter")
+	}
+
+	f.Clear()
+
+	if f.Contains(1) || f.Count() != 0 {
+		t.Errorf("Expected the filter to be empty")
+	}
+}
+
+func TestCountingBloomFilterSaturation(t *testing.T) {
+	f := NewCountingBloomFilter[int](10, 0.1, utility.IntegerHasher[int]())
+
+	for i := 0; i < 300; i++ {
+		f.Add(1)
+	}
+
+	for i := 0; i < 300; i++ {
+		f.Remove(1)
+	}
+
+	// saturated counters are never decremented, so there are no false negatives
+	if !f.Contains(1) {
+		t.Errorf("Expected 1 to stay in the filter")
+	}
+}
+
+func TestCountingBloomFilterMerge(t *testing.T) {
+	hasher := utility.StringHasher()
+	lhs := NewCountingBloomFilter[string](100, 0.01, hasher)
+	rhs := NewCountingBloomFilter[string](100, 0.01, hasher)
+
+	lhs.Add("foo")
+	rhs.Add("foo")
+	rhs.Add("bar")
+
+	if err := lhs.Merge(rhs); err != nil {
+		t.Fatalf("Merge() failed: %v", err)
+	}
+
+	lhs.Remove("foo")
+
+	if !lhs.Contains("foo") || !lhs.Contains("bar") || lhs.Count() != 2 {
+		t.Errorf("Expected the merged filter to count both copies")
+	}
+
+	if err := lhs.Merge(NewCountingBloomFilter[string](100, 0.2, hasher)); err != ErrIncompatible {
+		t.Errorf("Expected to get %v, got %v", ErrIncompatible, err)
+	}
+}
+
+func TestCountingBloomFilterEncoding(t *testing.T) {
+	hasher := utility.IntegerHasher[int]()
+	f := NewCountingBloomFilter[int](100, 0.01, hasher)
+
+	for i := 0; i < 100; i += 3 {
+		f.Add(i)
+	}
+
+	bin, err := f.MarshalBinary()
+	if err != nil {
+		t.Fatalf("MarshalBinary() failed: %v", err)
+	}
+
+	js, err := f.MarshalJSON()
+	if err != nil {
+		t.Fatalf("MarshalJSON() failed: %v", err)
+	}
+
+	fromBin := NewCountingBloomFilter[int](1, 0.5, hasher)
+	fromJSON := NewCountingBloomFilter[int](1, 0.5, hasher)
+
+	if err := fromBin.UnmarshalBinary(bin); err != nil {
+		t.Fatalf("UnmarshalBinary() failed: %v", err)
+	}
+
+	if err := fromJSON.UnmarshalJSON(js); err != nil {
+		t.Fatalf("UnmarshalJSON() failed: %v", err)
+	}
+
+	for _, g := range []*CountingBloomFilter[int]{fromBin, fromJSON} {
+		if g.Count() != f.Count() {
+			t.Errorf("Expected to get %d, got %d", f.Count(), g.Count())
+		}
+
+		for i := 0; i < 100; i++ {
+			if g.Contains(i) != f.Contains(i) {
+				t.Errorf("Expected Contains(%d) to be %t", i, f.Contains(i))
+			}
+		}
+
+		if !g.Remove(3) || g.Contains(3) && !f.Contains(3) {
+			t.Errorf("Expected 3 to be removed")
+		}
+	}
+
+	var zero CountingBloomFilter[int]
+
+	if err := zero.UnmarshalBinary(bin); err != ErrNoHasher {
+		t.Errorf("Expected to get %v, got %v", ErrNoHasher, err)
+	}
+}
@@ -0,0 +1,449 @@
+// Copyright 2021. The GTL Authors. All rights reserved.
+// https://github.com/modern-dev/gtl
+// Use of this source code is governed by the MIT
+// license that can be found in the LICENSE file.
+
+package probabilistic
+
+import (
+	"encoding/binary"
+	"encoding/json"
+	"fmt"
+
+	"github.com/modern-dev/gtl/containers/hash_map"
+	"github.com/modern-dev/gtl/internal/serial"
+)
+
+// binaryVersion is the version of the format produced by MarshalBinary.
+const binaryVersion byte = 1
+
+type (
+	bloomState struct {
+		Bits []uint64
+		M    uint64
+		K    int
+		N    int
+	}
+
+	scalableState struct {
+		Capacity int
+		FPR      float64
+		Stages   []bloomState
+		Limits   []int
+	}
+
+	countingState struct {
+		Counters []uint8
+		K        int
+		N        int
+	}
+
+	sketchState[T any] struct {
+		Width  int
+		Depth  int
+		Counts []uint64
+		Total  uint64
+		TopK   int
+		Heavy  []HeavyHitter[T]
+	}
+)
+
+// MarshalBinary implements the encoding.BinaryMarshaler interface.
+func (f *BloomFilter[T]) MarshalBinary() ([]byte, error) {
+	return serial.Marshal(binaryVersion, f.state())
+}
+
+// UnmarshalBinary implements the encoding.BinaryUnmarshaler interface.
+// The current contents of the BloomFilter are replaced, the filter keeps its hasher.
+func (f *BloomFilter[T]) UnmarshalBinary(data []byte) error {
+	if f.hasher == nil {
+		return ErrNoHasher
+	}
+
+	state, err := serial.Unmarshal[bloomState](data, binaryVersion)
+
+	if err != nil {
+		return err
+	}
+
+	return f.assign(state)
+}
+
+// MarshalJSON implements the json.Marshaler interface.
+func (f *BloomFilter[T]) MarshalJSON() ([]byte, error) {
+	return json.Marshal(f.state())
+}
+
+// UnmarshalJSON implements the json.Unmarshaler interface.
+// The current contents of the BloomFilter are replaced, the filter keeps its hasher.
+func (f *BloomFilter[T]) UnmarshalJSON(data []byte) error {
+	if f.hasher == nil {
+		return ErrNoHasher
+	}
+
+	var state bloomState
+
+	if err := json.Unmarshal(data, &state); err != nil {
+		return err
+	}
+
+	return f.assign(state)
+}
+
+func (f *BloomFilter[T]) state() bloomState {
+	return bloomState{append([]uint64{}, f.bits...), f.m, f.k, f.n}
+}
+
+func (f *BloomFilter[T]) assign(state bloomState) error {
+	if state.M == 0 || state.K <= 0 || state.N < 0 || uint64(len(state.Bits)) != (state.M+63)/64 {
+		return ErrCorrupted
+	}
+
+	f.bits, f.m, f.k, f.n = append([]uint64{}, state.Bits...), state.M, state.K, state.N
+
+	return nil
+}
+
+// MarshalBinary implements the encoding.BinaryMarshaler interface.
+func (f *ScalableBloomFilter[T]) MarshalBinary() ([]byte, error) {
+	return serial.Marshal(binaryVersion, f.state())
+}
+
+// UnmarshalBinary implements the encoding.BinaryUnmarshaler interface.
+// The current contents of the ScalableBloomFilter are replaced, the filter keeps its hasher.
+func (f *ScalableBloomFilter[T]) UnmarshalBinary(data []byte) error {
+	if f.hasher == nil {
+		return ErrNoHasher
+	}
+
+	state, err := serial.Unmarshal[scalableState](data, binaryVersion)
+
+	if err != nil {
+		return err
+	}
+
+	return f.assign(state)
+}
+
+// MarshalJSON implements the json.Marshaler interface.
+func (f *ScalableBloomFilter[T]) MarshalJSON() ([]byte, error) {
+	return json.Marshal(f.state())
+}
+
+// UnmarshalJSON implements the json.Unmarshaler interface.
+// The current contents of the ScalableBloomFilter are replaced, the filter keeps its hasher.
+func (f *ScalableBloomFilter[T]) UnmarshalJSON(data []byte) error {
+	if f.hasher == nil {
+		return ErrNoHasher
+	}
+
+	var state scalableState
+
+	if err := json.Unmarshal(data, &state); err != nil {
+		return err
+	}
+
+	return f.assign(state)
+}
+
+func (f *ScalableBloomFilter[T]) state() scalableState {
+	stages := make([]bloomState, len(f.stages))
+
+	for i, stage := range f.stages {
+		stages[i] = stage.state()
+	}
+
+	return scalableState{f.capacity, f.fpr, stages, append([]int{}, f.limits...)}
+}
+
+func (f *ScalableBloomFilter[T]) assign(state scalableState) error {
+	if state.Capacity <= 0 || state.FPR <= 0 || state.FPR >= 1 || len(state.Stages) == 0 ||
+		len(state.Limits) != len(state.Stages) {
+		return ErrCorrupted
+	}
+
+	res := &ScalableBloomFilter[T]{capacity: state.Capacity, fpr: state.FPR, hasher: f.hasher}
+
+	// the stages added by Merge do not follow the growth of the filter, so each one keeps its own size
+	for i, stageState := range state.Stages {
+		stage := &BloomFilter[T]{hasher: f.hasher}
+
+		if state.Limits[i] <= 0 {
+			return ErrCorrupted
+		}
+
+		if err := stage.assign(stageState); err != nil {
+			return err
+		}
+
+		res.stages = append(res.stages, stage)
+		res.limits = append(res.limits, state.Limits[i])
+	}
+
+	*f = *res
+
+	return nil
+}
+
+// MarshalBinary implements the encoding.BinaryMarshaler interface.
+func (f *CountingBloomFilter[T]) MarshalBinary() ([]byte, error) {
+	return serial.Marshal(binaryVersion, f.state())
+}
+
+// UnmarshalBinary implements the encoding.BinaryUnmarshaler interface.
+// The current contents of the CountingBloomFilter are replaced, the filter keeps its hasher.
+func (f *CountingBloomFilter[T]) UnmarshalBinary(data []byte) error {
+	if f.hasher == nil {
+		return ErrNoHasher
+	}
+
+	state, err := serial.Unmarshal[countingState](data, binaryVersion)
+
+	if err != nil {
+		return err
+	}
+
+	return f.assign(state)
+}
+
+// MarshalJSON implements the json.Marshaler interface.
+func (f *CountingBloomFilter[T]) MarshalJSON() ([]byte, error) {
+	return json.Marshal(f.state())
+}
+
+// UnmarshalJSON implements the json.Unmarshaler interface.
+// The current contents of the CountingBloomFilter are replaced, the filter keeps its hasher.
+func (f *CountingBloomFilter[T]) UnmarshalJSON(data []byte) error {
+	if f.hasher == nil {
+		return ErrNoHasher
+	}
+
+	var state countingState
+
+	if err := json.Unmarshal(data, &state); err != nil {
+		return err
+	}
+
+	return f.assign(state)
+}
+
+func (f *CountingBloomFilter[T]) state() countingState {
+	return countingState{append([]uint8{}, f.counters...), f.k, f.n}
+}
+
+func (f *CountingBloomFilter[T]) assign(state countingState) error {
+	if len(state.Counters) == 0 || state.K <= 0 || state.N < 0 {
+		return ErrCorrupted
+	}
+
+	f.counters, f.k, f.n = append([]uint8{}, state.Counters...), state.K, state.N
+
+	return nil
+}
+
+// MarshalBinary implements the encoding.BinaryMarshaler interface.
+// The tracked heavy hitters are encoded along with the counters, so T has to be encodable by encoding/gob.
+func (s *CountMinSketch[T]) MarshalBinary() ([]byte, error) {
+	return serial.Marshal(binaryVersion, s.state())
+}
+
+// UnmarshalBinary implements the encoding.BinaryUnmarshaler interface.
+// The current contents of the CountMinSketch are replaced, the sketch keeps its hasher.
+func (s *CountMinSketch[T]) UnmarshalBinary(data []byte) error {
+	if s.hasher == nil {
+		return ErrNoHasher
+	}
+
+	state, err := serial.Unmarshal[sketchState[T]](data, binaryVersion)
+
+	if err != nil {
+		return err
+	}
+
+	return s.assign(state)
+}
+
+// MarshalJSON implements the json.Marshaler interface.
+// The tracked heavy hitters are encoded along with the counters, so T has to be encodable by encoding/json.
+func (s *CountMinSketch[T]) MarshalJSON() ([]byte, error) {
+	return json.Marshal(s.state())
+}
+
+// UnmarshalJSON implements the json.Unmarshaler interface.
+// The current contents of the CountMinSketch are replaced, the sketch keeps its hasher.
+func (s *CountMinSketch[T]) UnmarshalJSON(data []byte) error {
+	if s.hasher == nil {
+		return ErrNoHasher
+	}
+
+	var state sketchState[T]
+
+	if err := json.Unmarshal(data, &state); err != nil {
+		return err
+	}
+
+	return s.assign(state)
+}
+
+func (s *CountMinSketch[T]) state() sketchState[T] {
+	return sketchState[T]{s.width, s.depth, append([]uint64{}, s.counts...), s.total, s.topK, s.HeavyHitters()}
+}
+
+func (s *CountMinSketch[T]) assign(state sketchState[T]) error {
+	if state.Width <= 0 || state.Depth <= 0 || len(state.Counts) != state.Width*state.Depth ||
+		state.TopK < 0 || len(state.Heavy) > state.TopK {
+		return ErrCorrupted
+	}
+
+	s.counts, s.width, s.depth = append([]uint64{}, state.Counts...), state.Width, state.Depth
+	s.total, s.topK = state.Total, state.TopK
+	s.heavy = hash_map.NewHashMap[T, uint64](s.hasher)
+	s.minHeavy = newMinHeavy(state.Heavy)
+
+	for _, h := range state.Heavy {
+		s.heavy.Insert(h.Item, h.Count)
+	}
+
+	return nil
+}
+
+// MarshalBinary implements the encoding.BinaryMarshaler interface.
+// Unlike the other containers of the package, the layout is fixed so that sketches
+// can be exchanged between programs and versions:
+//
+//	version byte, currently 1
+//	precision byte
+//	representation byte, 0 for sparse and 1 for dense
+//	sparse: the number of registers as uvarint followed by the registers in ascending order,
+//	        each as uvarint of the difference between idx << 6 | value and the previous one
+//	dense: 2^precision registers, one byte each
+//
+// The registers added since the last compaction of the sparse list are merged into it first.
+func (h *HyperLogLog[T]) MarshalBinary() ([]byte, error) {
+	if h.registers == nil {
+		h.flush()
+	}
+
+	if h.registers != nil {
+		return append([]byte{binaryVersion, byte(h.p), 1}, h.registers...), nil
+	}
+
+	// the sparse list is kept in the encoded form
+	data := make([]byte, 3+binary.MaxVarintLen64, 3+binary.MaxVarintLen64+len(h.sparse))
+	data[0], data[1], data[2] = binaryVersion, byte(h.p), 0
+	n := 3 + binary.PutUvarint(data[3:], uint64(h.sparseCount))
+
+	return append(data[:n], h.sparse...), nil
+}
+
+// UnmarshalBinary implements the encoding.BinaryUnmarshaler interface.
+// The current contents of the HyperLogLog are replaced, the sketch keeps its hasher.
+func (h *HyperLogLog[T]) UnmarshalBinary(data []byte) error {
+	if h.hasher == nil {
+		return ErrNoHasher
+	}
+
+	if len(data) < 3 {
+		return serial.ErrTruncated
+	}
+
+	if data[0] != binaryVersion {
+		return fmt.Errorf("probabilistic: unsupported format version %d, expected %d", data[0], binaryVersion)
+	}
+
+	p := int(data[1])
+
+	if p < MinPrecision || p > MaxPrecision {
+		return ErrCorrupted
+	}
+
+	switch data[2] {
+	case 0:
+		sparse, count, err := decodeSparse(data[3:], p)
+
+		if err != nil {
+			return err
+		}
+
+		h.p, h.sparse, h.sparseCount, h.pending, h.registers = p, sparse, count, nil, nil
+	case 1:
+		registers := data[3:]
+
+		if len(registers) != 1<<p {
+			return ErrCorrupted
+		}
+
+		for _, r := range registers {
+			if int(r) > 65-p {
+				return ErrCorrupted
+			}
+		}
+
+		h.p, h.sparse, h.sparseCount, h.pending, h.registers = p, nil, 0, nil, append([]uint8{}, registers...)
+	default:
+		return ErrCorrupted
+	}
+
+	return nil
+}
+
+// MarshalJSON implements the json.Marshaler interface.
+// The HyperLogLog is encoded as a JSON string holding the base64 of its binary encoding.
+func (h *HyperLogLog[T]) MarshalJSON() ([]byte, error) {
+	data, _ := h.MarshalBinary()
+
+	return json.Marshal(data)
+}
+
+// UnmarshalJSON implements the json.Unmarshaler interface.
+// The current contents of the HyperLogLog are replaced, the sketch keeps its hasher.
+func (h *HyperLogLog[T]) UnmarshalJSON(data []byte) error {
+	if h.hasher == nil {
+		return ErrNoHasher
+	}
+
+	var bin []byte
+
+	if err := json.Unmarshal(data, &bin); err != nil {
+		return err
+	}
+
+	return h.UnmarshalBinary(bin)
+}
+
+// decodeSparse validates the encoded sparse list and returns a copy of it along with the number of registers.
+func decodeSparse(data []byte, p int) ([]byte, int, error) {
+	size, n := binary.Uvarint(data)
+
+	// larger lists are never produced, as the sketch switches to the dense representation
+	if n <= 0 || len(data)-n > 1<<p {
+		return nil, 0, ErrCorrupted
+	}
+
+	list := data[n:]
+	data = list
+	prev := uint64(0)
+
+	for i := uint64(0); i < size; i++ {
+		delta, n := binary.Uvarint(data)
+
+		if n <= 0 || delta >= 1<<31 || (i > 0 && delta == 0) {
+			return nil, 0, ErrCorrupted
+		}
+
+		data = data[n:]
+		idx, r := (prev+delta)>>6, uint8((prev+delta)&63)
+
+		if idx >= 1<<sparsePrecision || r == 0 || r > 64-sparsePrecision+1 || (i > 0 && idx == prev>>6) {
+			return nil, 0, ErrCorrupted
+		}
+
+		prev += delta
+	}
+
+	if len(data) != 0 {
+		return nil, 0, ErrCorrupted
+	}
+
+	return append([]byte{}, list...), int(size), nil
+}
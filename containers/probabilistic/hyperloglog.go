@@ -0,0 +1,375 @@
+// Copyright 2021. The GTL Authors. All rights reserved.
+// https://github.com/modern-dev/gtl
+// Use of this source code is governed by the MIT
+// license that can be found in the LICENSE file.
+
+package probabilistic
+
+import (
+	"encoding/binary"
+	"math"
+	"math/bits"
+	"sort"
+
+	"github.com/modern-dev/gtl/internal/hashing"
+	"github.com/modern-dev/gtl/utility"
+)
+
+const (
+	// MinPrecision is the smallest precision of a HyperLogLog.
+	MinPrecision = 4
+	// MaxPrecision is the largest precision of a HyperLogLog.
+	MaxPrecision = 18
+	// sparsePrecision is the precision of the indexes kept by the sparse representation
+	sparsePrecision = 25
+)
+
+// HyperLogLog estimates the number of distinct elements using a fixed amount of memory,
+// see Heule et al., "HyperLogLog in Practice: Algorithmic Engineering of a State of The Art Cardinality Estimation
+// Algorithm". The relative standard error is about 1.04 / sqrt(2^p), where p is the precision.
+//
+// Small cardinalities are kept in the sparse representation: only the set registers out of 2^25 are stored,
+// packed with their values into a sorted list of varint encoded differences. New registers are collected
+// in a small buffer and merged into the list in batches. The sparse representation is more accurate
+// than the dense one, a slice of 2^p one byte registers, and the sketch switches to the dense representation
+// once the list grows larger than 2^p bytes.
+//
+// Instead of the empirical bias correction of HyperLogLog++, the cardinality is estimated
+// from the register histogram as in Ertl, "New cardinality estimation algorithms for HyperLogLog sketches",
+// which is unbiased across the whole range of cardinalities.
+type HyperLogLog[T any] struct {
+	p int
+	// sparse holds the sparse registers packed as idx << 6 | value in ascending order,
+	// each encoded as uvarint of the difference with the previous one
+	sparse      []byte
+	sparseCount int
+	// pending holds the packed sparse registers that are not merged into sparse yet
+	pending   []uint32
+	registers []uint8
+	hasher    utility.Hasher[T]
+}
+
+// NewHyperLogLog creates an empty HyperLogLog with 2^precision dense registers.
+// Panics if precision is not in [MinPrecision, MaxPrecision].
+func NewHyperLogLog[T any](precision int, hasher utility.Hasher[T]) *HyperLogLog[T] {
+	if precision < MinPrecision || precision > MaxPrecision {
+		panic("probabilistic: precision must be in [4, 18]")
+	}
+
+	return &HyperLogLog[T]{
+		p:      precision,
+		hasher: hasher,
+	}
+}
+
+// Add inserts the element into the sketch.
+// Complexity - O(1) amortized.
+func (h *HyperLogLog[T]) Add(item T) {
+	hash := hashing.Mix(h.hasher.Hash(item))
+
+	if h.registers != nil {
+		h.setRegister(uint32(hash>>(64-h.p)), rank(hash<<h.p, 64-h.p))
+
+		return
+	}
+
+	h.addSparse(uint32(hash>>(64-sparsePrecision))<<6 | uint32(rank(hash<<sparsePrecision, 64-sparsePrecision)))
+}
+
+// Estimate returns the estimated number of distinct elements added to the sketch.
+// Complexity - O(m), where m is the number of the stored registers.
+func (h *HyperLogLog[T]) Estimate() uint64 {
+	if h.registers == nil {
+		h.flush()
+	}
+
+	if h.registers == nil {
+		hist := make([]int, 64-sparsePrecision+2)
+		hist[0] = 1<<sparsePrecision - h.sparseCount
+
+		h.eachSparse(func(entry uint32) {
+			hist[entry&63]++
+		})
+
+		return estimate(hist, sparsePrecision)
+	}
+
+	hist := make([]int, 64-h.p+2)
+
+	for _, r := range h.registers {
+		hist[r]++
+	}
+
+	return estimate(hist, h.p)
+}
+
+// Precision returns the precision of the sketch.
+// Complexity - O(1).
+func (h *HyperLogLog[T]) Precision() int {
+	return h.p
+}
+
+// Sparse checks if the sketch uses the sparse representation.
+// Complexity - O(1).
+func (h *HyperLogLog[T]) Sparse() bool {
+	return h.registers == nil
+}
+
+// Merge adds all elements of the other sketch into this one.
+// Returns ErrIncompatible if the sketches have different precisions.
+// Both sketches must use the same hasher.
+// Complexity - O(m), where m is the number of the stored registers of both sketches.
+func (h *HyperLogLog[T]) Merge(other *HyperLogLog[T]) error {
+	if h.p != other.p {
+		return ErrIncompatible
+	}
+
+	if h == other {
+		return nil
+	}
+
+	if other.registers == nil {
+		other.eachSparse(func(entry uint32) {
+			if h.registers != nil {
+				h.setRegister(h.denseEntry(entry))
+			} else {
+				h.addSparse(entry)
+			}
+		})
+
+		return nil
+	}
+
+	if h.registers == nil {
+		h.toDense()
+	}
+
+	for i, r := range other.registers {
+		if r > h.registers[i] {
+			h.registers[i] = r
+		}
+	}
+
+	return nil
+}
+
+// Clear removes all elements from the sketch, switching it back to the sparse representation.
+// Complexity - O(1).
+func (h *HyperLogLog[T]) Clear() {
+	h.sparse, h.sparseCount, h.pending, h.registers = nil, 0, nil, nil
+}
+
+func (h *HyperLogLog[T]) setRegister(idx uint32, r uint8) {
+	if r > h.registers[idx] {
+		h.registers[idx] = r
+	}
+}
+
+func (h *HyperLogLog[T]) addSparse(entry uint32) {
+	h.pending = append(h.pending, entry)
+
+	if len(h.pending) >= maxInt(1<<h.p/64, 4) {
+		h.flush()
+	}
+}
+
+// flush merges the pending registers into the sparse list,
+// switching to the dense representation if the list becomes larger than the dense registers.
+func (h *HyperLogLog[T]) flush() {
+	if len(h.pending) == 0 {
+		return
+	}
+
+	sort.Slice(h.pending, func(i, j int) bool {
+		return h.pending[i] < h.pending[j]
+	})
+
+	var w sparseWriter
+
+	r := sparseReader{data: h.sparse}
+	entry, ok := r.next()
+
+	for i := 0; ok || i < len(h.pending); {
+		if ok && (i == len(h.pending) || entry <= h.pending[i]) {
+			w.add(entry)
+			entry, ok = r.next()
+		} else {
+			w.add(h.pending[i])
+			i++
+		}
+	}
+
+	h.sparse, h.sparseCount = w.finish()
+	h.pending = h.pending[:0]
+
+	if len(h.sparse) > 1<<h.p {
+		h.toDense()
+	}
+}
+
+func (h *HyperLogLog[T]) toDense() {
+	h.registers = make([]uint8, 1<<h.p)
+
+	h.eachSparse(func(entry uint32) {
+		h.setRegister(h.denseEntry(entry))
+	})
+
+	h.sparse, h.sparseCount, h.pending = nil, 0, nil
+}
+
+// eachSparse calls fn for every sparse register, including the pending ones.
+func (h *HyperLogLog[T]) eachSparse(fn func(entry uint32)) {
+	r := sparseReader{data: h.sparse}
+
+	for entry, ok := r.next(); ok; entry, ok = r.next() {
+		fn(entry)
+	}
+
+	for _, entry := range h.pending {
+		fn(entry)
+	}
+}
+
+// denseEntry converts the packed sparse register to the dense one.
+// The sparse index holds 25 - p more bits of the hash, which precede the bits the sparse rank was taken from.
+func (h *HyperLogLog[T]) denseEntry(entry uint32) (uint32, uint8) {
+	idx, r := entry>>6, uint8(entry&63)
+	shift := sparsePrecision - h.p
+	rest := uint64(idx) & (1<<shift - 1)
+
+	if rest == 0 {
+		return idx >> shift, uint8(shift) + r
+	}
+
+	return idx >> shift, rank(rest<<(64-shift), shift)
+}
+
+// sparseReader decodes the sparse list.
+type sparseReader struct {
+	data []byte
+	last uint32
+}
+
+func (r *sparseReader) next() (uint32, bool) {
+	if len(r.data) == 0 {
+		return 0, false
+	}
+
+	delta, n := binary.Uvarint(r.data)
+	r.data = r.data[n:]
+	r.last += uint32(delta)
+
+	return r.last, true
+}
+
+// sparseWriter encodes the sparse list from the packed registers added in ascending order.
+// Of the registers with the same index only the last, that is the largest, one is kept.
+type sparseWriter struct {
+	data    []byte
+	count   int
+	last    uint32
+	cur     uint32
+	started bool
+}
+
+func (w *sparseWriter) add(entry uint32) {
+	if w.started && entry>>6 != w.cur>>6 {
+		w.write()
+	}
+
+	w.cur, w.started = entry, true
+}
+
+func (w *sparseWriter) finish() ([]byte, int) {
+	if w.started {
+		w.write()
+	}
+
+	// the list is kept for long, so do not waste the spare capacity
+	return append(make([]byte, 0, len(w.data)), w.data...), w.count
+}
+
+func (w *sparseWriter) write() {
+	var buf [binary.MaxVarintLen32]byte
+
+	n := binary.PutUvarint(buf[:], uint64(w.cur-w.last))
+	w.data = append(w.data, buf[:n]...)
+	w.last = w.cur
+	w.count++
+}
+
+// rank returns the position of the leftmost set bit among the top width bits of hash, starting at 1,
+// or width + 1 if they are all zero.
+func rank(hash uint64, width int) uint8 {
+	return uint8(minInt(bits.LeadingZeros64(hash), width) + 1)
+}
+
+// estimate computes the cardinality from the histogram of the register values,
+// where hist[k] is the number of registers equal to k, see Ertl, Algorithm 6.
+func estimate(hist []int, p int) uint64 {
+	m := float64(int(1) << p)
+	q := len(hist) - 2
+	z := m * tau(1-float64(hist[q+1])/m)
+
+	for k := q; k >= 1; k-- {
+		z = 0.5 * (z + float64(hist[k]))
+	}
+
+	z += m * sigma(float64(hist[0])/m)
+
+	return uint64(math.Round(m * m / (2 * math.Ln2 * z)))
+}
+
+func sigma(x float64) float64 {
+	if x == 1 {
+		return math.Inf(1)
+	}
+
+	y, z := 1.0, x
+
+	for {
+		x *= x
+		prev := z
+		z += x * y
+		y += y
+
+		if z == prev {
+			return z
+		}
+	}
+}
+
+func tau(x float64) float64 {
+	if x == 0 || x == 1 {
+		return 0
+	}
+
+	y, z := 1.0, 1-x
+
+	for {
+		x = math.Sqrt(x)
+		prev := z
+		y *= 0.5
+		z -= (1 - x) * (1 - x) * y
+
+		if z == prev {
+			return z / 3
+		}
+	}
+}
+
+func maxInt(lhs, rhs int) int {
+	if lhs > rhs {
+		return lhs
+	}
+
+	return rhs
+}
+
+func minInt(lhs, rhs int) int {
+	if lhs < rhs {
+		return lhs
+	}
+
+	return rhs
+}
@@ -0,0 +1,240 @@
+// Copyright 2021. The GTL Authors. All rights reserved.
+// https://github.com/modern-dev/gtl
+// Use of this source code is governed by the MIT
+// license that can be found in the LICENSE file.
+
+package probabilistic
+
+import (
+	"bytes"
+	"math"
+	"testing"
+
+	"github.com/modern-dev/gtl/utility"
+)
+
+// checkEstimate checks that the estimate is within the given relative error of the cardinality.
+func checkEstimate(t *testing.T, h *HyperLogLog[int], cardinality int, relErr float64) {
+	t.Helper()
+
+	if got := float64(h.Estimate()); math.Abs(got-float64(cardinality)) > relErr*float64(cardinality) {
+		t.Errorf("Expected to get %d ± %.1f%%, got %.0f", cardinality, relErr*100, got)
+	}
+}
+
+func TestHyperLogLog(t *testing.T) {
+	h := NewHyperLogLog[int](14, utility.IntegerHasher[int]())
+
+	if h.Estimate() != 0 || !h.Sparse() {
+		t.Errorf("Expected to get (0, true), got (%d, %t)", h.Estimate(), h.Sparse())
+	}
+
+	for i := 0; i < 1000; i++ {
+		h.Add(i)
+		h.Add(i)
+	}
+
+	// the sparse representation is nearly exact
+	if !h.Sparse() {
+		t.Errorf("Expected the sketch to be sparse")
+	}
+
+	checkEstimate(t, h, 1000, 0.002)
+
+	for i := 1000; i < 1000000; i++ {
+		h.Add(i)
+	}
+
+	if h.Sparse() {
+		t.Errorf("Expected the sketch to be dense")
+	}
+
+	// 1.04 / sqrt(2^14) is about 0.8%
+	checkEstimate(t, h, 1000000, 0.025)
+
+	h.Clear()
+
+	if h.Estimate() != 0 || !h.Sparse() {
+		t.Errorf("Expected to get (0, true), got (%d, %t)", h.Estimate(), h.Sparse())
+	}
+}
+
+func TestHyperLogLogSparseSize(t *testing.T) {
+	const p = 14
+
+	h := NewHyperLogLog[int](p, utility.IntegerHasher[int]())
+	footprint := func() int {
+		return cap(h.sparse) + 4*cap(h.pending)
+	}
+
+	for i := 0; i < 4000; i++ {
+		h.Add(i)
+
+		// the sparse list never outgrows the dense registers, apart from the small buffer
+		if f := footprint(); f > 1<<p+1<<p/16 {
+			t.Fatalf("Expected at most %d bytes, got %d", 1<<p+1<<p/16, f)
+		}
+	}
+
+	if !h.Sparse() {
+		t.Fatalf("Expected the sketch to be sparse")
+	}
+
+	if f := footprint(); f >= 1<<p {
+		t.Errorf("Expected the sparse representation to be smaller than %d bytes, got %d", 1<<p, f)
+	}
+
+	checkEstimate(t, h, 4000, 0.002)
+
+	for i := 4000; h.Sparse(); i++ {
+		h.Add(i)
+	}
+
+	if h.sparse != nil || h.pending != nil || len(h.registers) != 1<<p {
+		t.Errorf("Expected only the dense registers to be kept")
+	}
+}
+
+func TestHyperLogLogAccuracy(t *testing.T) {
+	for _, p := range []int{MinPrecision, 10, MaxPrecision} {
+		h := NewHyperLogLog[int](p, utility.IntegerHasher[int]())
+		relErr := 4 * 1.04 / math.Sqrt(float64(int(1)<<p))
+		added := 0
+
+		for _, n := range []int{10, 100, 1000, 10000, 100000} {
+			for ; added < n; added++ {
+				h.Add(added)
+			}
+
+			checkEstimate(t, h, n, relErr)
+		}
+	}
+}
+
+func TestHyperLogLogMerge(t *testing.T) {
+	hasher := utility.IntegerHasher[int]()
+
+	for _, sizes := range [][2]int{{100, 200}, {100, 50000}, {50000, 100}, {50000, 80000}} {
+		lhs := NewHyperLogLog[int](12, hasher)
+		rhs := NewHyperLogLog[int](12, hasher)
+		union := NewHyperLogLog[int](12, hasher)
+
+		// the halves of the ranges overlap
+		for i := 0; i < sizes[0]; i++ {
+			lhs.Add(i)
+			union.Add(i)
+		}
+
+		for i := sizes[0] / 2; i < sizes[0]/2+sizes[1]; i++ {
+			rhs.Add(i)
+			union.Add(i)
+		}
+
+		if err := lhs.Merge(rhs); err != nil {
+			t.Fatalf("Merge() failed: %v", err)
+		}
+
+		// merging is exact, the result is the sketch of the union
+		if lhs.Estimate() != union.Estimate() || lhs.Sparse() != union.Sparse() {
+			t.Errorf("Expected to get (%d, %t), got (%d, %t)",
+				union.Estimate(), union.Sparse(), lhs.Estimate(), lhs.Sparse())
+		}
+	}
+
+	if err := NewHyperLogLog[int](12, hasher).Merge(NewHyperLogLog[int](10, hasher)); err != ErrIncompatible {
+		t.Errorf("Expected to get %v, got %v", ErrIncompatible, err)
+	}
+}
+
+func TestHyperLogLogEncoding(t *testing.T) {
+	hasher := utility.IntegerHasher[int]()
+	h := NewHyperLogLog[int](10, hasher)
+
+	for _, n := range []int{100, 10000} {
+		for i := 0; i < n; i++ {
+			h.Add(i)
+		}
+
+		bin, err := h.MarshalBinary()
+		if err != nil {
+			t.Fatalf("MarshalBinary() failed: %v", err)
+		}
+
+		js, err := h.MarshalJSON()
+		if err != nil {
+			t.Fatalf("MarshalJSON() failed: %v", err)
+		}
+
+		fromBin := NewHyperLogLog[int](4, hasher)
+		fromJSON := NewHyperLogLog[int](4, hasher)
+
+		if err := fromBin.UnmarshalBinary(bin); err != nil {
+			t.Fatalf("UnmarshalBinary() failed: %v", err)
+		}
+
+		if err := fromJSON.UnmarshalJSON(js); err != nil {
+			t.Fatalf("UnmarshalJSON() failed: %v", err)
+		}
+
+		for _, g := range []*HyperLogLog[int]{fromBin, fromJSON} {
+			if g.Precision() != 10 || g.Sparse() != h.Sparse() || g.Estimate() != h.Estimate() {
+				t.Errorf("Expected to get (10, %t, %d), got (%d, %t, %d)",
+					h.Sparse(), h.Estimate(), g.Precision(), g.Sparse(), g.Estimate())
+			}
+
+			if again, _ := g.MarshalBinary(); !bytes.Equal(again, bin) {
+				t.Errorf("Expected the encoding to be the same after decoding")
+			}
+		}
+	}
+
+	var zero HyperLogLog[int]
+
+	if err := zero.UnmarshalBinary([]byte{1, 10, 0, 0}); err != ErrNoHasher {
+		t.Errorf("Expected to get %v, got %v", ErrNoHasher, err)
+	}
+}
+
+func TestHyperLogLogStableEncoding(t *testing.T) {
+	hasher := utility.IntegerHasher[int]()
+	h := NewHyperLogLog[int](4, hasher)
+
+	h.Add(1)
+	h.Add(2)
+
+	// the layout must not change, sketches encoded by the previous releases have to be readable
+	want := []byte{1, 4, 0, 2, 131, 150, 164, 218, 2, 192, 162, 128, 149, 4}
+
+	if got, _ := h.MarshalBinary(); !bytes.Equal(got, want) {
+		t.Errorf("Expected to get %v, got %v", want, got)
+	}
+
+	dense := append([]byte{1, 4, 1}, make([]byte, 16)...)
+	dense[3], dense[18] = 3, 61
+
+	if err := h.UnmarshalBinary(dense); err != nil {
+		t.Fatalf("UnmarshalBinary() failed: %v", err)
+	}
+
+	if h.Sparse() || h.Estimate() == 0 {
+		t.Errorf("Expected to get a non-empty dense sketch")
+	}
+
+	for _, data := range [][]byte{
+		{1, 3, 0, 0},        // precision out of range
+		{1, 4, 2, 0},        // unknown representation
+		{1, 4, 0, 1},        // missing register
+		{1, 4, 0, 1, 0},     // zero register
+		{1, 4, 0, 0, 0},     // trailing data
+		{1, 4, 1, 0},        // short dense registers
+		{1, 4, 0, 2, 65, 1}, // duplicate index
+	} {
+		if err := h.UnmarshalBinary(data); err != ErrCorrupted {
+			t.Errorf("Expected to get %v for %v, got %v", ErrCorrupted, data, err)
+		}
+	}
+
+	if err := h.UnmarshalBinary([]byte{2, 4, 0, 0}); err == nil {
+		t.Errorf("Expected the unsupported version to be rejected")
+	}
+}
@@ -0,0 +1,58 @@
+// Copyright 2021. The GTL Authors. All rights reserved.
+// https://github.com/modern-dev/gtl
+// Use of this source code is governed by the MIT
+// license that can be found in the LICENSE file.
+
+// Package probabilistic implements compact containers answering membership, frequency and cardinality queries approximately.
+// Elements are hashed with a utility.Hasher.
+package probabilistic
+
+import (
+	"errors"
+	"math"
+
+	"github.com/modern-dev/gtl/internal/hashing"
+)
+
+var (
+	// ErrIncompatible is returned when merging containers of different sizes.
+	ErrIncompatible = errors.New("probabilistic: containers have different parameters")
+	// ErrNoHasher is returned when decoding into a container that was not created by one of the constructors.
+	// The hasher is not a part of the encoded data, so the container has to be created before decoding
+	// with the same hasher as the encoded one.
+	ErrNoHasher = errors.New("probabilistic: hasher is not set")
+	// ErrCorrupted is returned when decoding data that does not describe a valid container.
+	ErrCorrupted = errors.New("probabilistic: data is corrupted")
+)
+
+// probes derives a sequence of positions in [0, size) from a single hash with double hashing,
+// see Kirsch and Mitzenmacher, "Less Hashing, Same Performance: Building a Better Bloom Filter".
+type probes struct {
+	h1, h2 uint64
+}
+
+func newProbes(hash uint64) probes {
+	return probes{hashing.Mix(hash), hashing.Mix(hash^hashing.Golden) | 1}
+}
+
+// at returns the i-th position in [0, size).
+func (p probes) at(i int, size uint64) uint64 {
+	return (p.h1 + uint64(i)*p.h2) % size
+}
+
+// optimalBloomSize returns the number of bits and hash functions of a Bloom filter
+// holding capacity elements with the false positive rate fpr.
+func optimalBloomSize(capacity int, fpr float64) (uint64, int) {
+	if capacity <= 0 {
+		panic("probabilistic: capacity must be positive")
+	}
+
+	if fpr <= 0 || fpr >= 1 {
+		panic("probabilistic: false positive rate must be in (0, 1)")
+	}
+
+	m := math.Ceil(-float64(capacity) * math.Log(fpr) / (math.Ln2 * math.Ln2))
+	k := math.Round(m / float64(capacity) * math.Ln2)
+
+	return uint64(m), int(math.Max(k, 1))
+}
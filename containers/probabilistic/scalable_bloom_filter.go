@@ -0,0 +1,145 @@
+// Copyright 2021. The GTL Authors. All rights reserved.
+// https://github.com/modern-dev/gtl
+// Use of this source code is governed by the MIT
+// license that can be found in the LICENSE file.
+
+package probabilistic
+
+import (
+	"math"
+
+	"github.com/modern-dev/gtl/utility"
+)
+
+const (
+	// growth is the ratio of the capacities of the consecutive stages
+	growth = 2
+	// tightening is the ratio of the false positive rates of the consecutive stages
+	tightening = 0.5
+)
+
+// ScalableBloomFilter is a Bloom filter that grows to hold any number of elements
+// keeping the false positive rate under the target, see Almeida et al., "Scalable Bloom Filters".
+// When a stage is full, a new one is added with twice the capacity and half the false positive rate,
+// so the rates of all stages sum up to at most the target. Merge may add the stages of the other filter,
+// then the rate is bounded by the sum of the targets of the merged filters.
+type ScalableBloomFilter[T any] struct {
+	stages []*BloomFilter[T]
+	// limits holds the number of elements each stage is sized for
+	limits   []int
+	capacity int
+	fpr      float64
+	hasher   utility.Hasher[T]
+}
+
+// NewScalableBloomFilter creates an empty ScalableBloomFilter whose first stage holds capacity elements
+// and whose false positive rate never exceeds fpr unless other filters are merged into it, see Merge.
+// Panics if capacity is not positive or fpr is not in (0, 1).
+func NewScalableBloomFilter[T any](capacity int, fpr float64, hasher utility.Hasher[T]) *ScalableBloomFilter[T] {
+	f := &ScalableBloomFilter[T]{capacity: capacity, fpr: fpr, hasher: hasher}
+	f.grow()
+
+	return f
+}
+
+// Add inserts the element into the filter, adding a stage if the last one is full.
+// Elements that may already be in the filter are not added again, so they do not fill the stages.
+// Complexity - O(s * k), where s is the number of stages and k is the number of hash functions.
+func (f *ScalableBloomFilter[T]) Add(item T) {
+	if f.Contains(item) {
+		return
+	}
+
+	if last := len(f.stages) - 1; f.stages[last].Count() >= f.limits[last] {
+		f.grow()
+	}
+
+	f.stages[len(f.stages)-1].Add(item)
+}
+
+// Contains checks if the element may have been inserted.
+// Returns false only if it definitely has not been.
+// Complexity - O(s * k), where s is the number of stages and k is the number of hash functions.
+func (f *ScalableBloomFilter[T]) Contains(item T) bool {
+	for _, stage := range f.stages {
+		if stage.Contains(item) {
+			return true
+		}
+	}
+
+	return false
+}
+
+// Count returns the number of elements added to the filter.
+// Complexity - O(s), where s is the number of stages.
+func (f *ScalableBloomFilter[T]) Count() int {
+	n := 0
+
+	for _, stage := range f.stages {
+		n += stage.Count()
+	}
+
+	return n
+}
+
+// Stages returns the number of stages of the filter.
+// Complexity - O(1).
+func (f *ScalableBloomFilter[T]) Stages() int {
+	return len(f.stages)
+}
+
+// Merge adds all elements of the other filter into this one.
+// A stage of the other filter is merged into the stage at the same position if they hold together
+// no more elements than the stage is sized for, otherwise it is added as a new stage.
+// Overfilled stages would make the false positive rate grow without bound, while with the added stages
+// it stays under the sum of the targets of the merged filters.
+// Returns ErrIncompatible if the filters were created with different capacities or false positive rates.
+// Both filters must use the same hasher.
+// Complexity - O(m), where m is the total number of bits.
+func (f *ScalableBloomFilter[T]) Merge(other *ScalableBloomFilter[T]) error {
+	if f.capacity != other.capacity || f.fpr != other.fpr {
+		return ErrIncompatible
+	}
+
+	if f == other {
+		return nil
+	}
+
+	own := len(f.stages)
+
+	for i, stage := range other.stages {
+		if stage.Count() == 0 {
+			continue
+		}
+
+		if i < own && f.stages[i].Count()+stage.Count() <= f.limits[i] && f.stages[i].Merge(stage) == nil {
+			continue
+		}
+
+		f.stages = append(f.stages, stage.clone())
+		f.limits = append(f.limits, other.limits[i])
+	}
+
+	return nil
+}
+
+// Clear removes all elements and stages except the first one.
+// Complexity - O(m), where m is the number of bits of the first stage.
+func (f *ScalableBloomFilter[T]) Clear() {
+	f.stages, f.limits = f.stages[:1], f.limits[:1]
+	f.stages[0].Clear()
+}
+
+// grow adds a new stage with twice the capacity of the last one and a tighter false positive rate.
+func (f *ScalableBloomFilter[T]) grow() {
+	i, capacity := len(f.stages), f.capacity
+
+	if i > 0 {
+		capacity = f.limits[i-1] * growth
+	}
+
+	fpr := f.fpr * (1 - tightening) * math.Pow(tightening, float64(i))
+
+	f.stages = append(f.stages, NewBloomFilter[T](capacity, fpr, f.hasher))
+	f.limits = append(f.limits, capacity)
+}
@@ -0,0 +1,30 @@
+// Copyright 2021. The GTL Authors. All rights reserved.
+// https://github.com/modern-dev/gtl
+// Use of this source code is governed by the MIT
+// license that can be found in the LICENSE file.
+
+package queue
+
+// MarshalBinary implements the encoding.BinaryMarshaler interface.
+// The elements are encoded in order from front to back.
+func (q *Queue[T]) MarshalBinary() ([]byte, error) {
+	return q.dq.MarshalBinary()
+}
+
+// UnmarshalBinary implements the encoding.BinaryUnmarshaler interface.
+// The current contents of the Queue are replaced.
+func (q *Queue[T]) UnmarshalBinary(data []byte) error {
+	return q.dq.UnmarshalBinary(data)
+}
+
+// MarshalJSON implements the json.Marshaler interface.
+// The Queue is encoded as a JSON array ordered from front to back.
+func (q *Queue[T]) MarshalJSON() ([]byte, error) {
+	return q.dq.MarshalJSON()
+}
+
+// UnmarshalJSON implements the json.Unmarshaler interface.
+// The current contents of the Queue are replaced.
+func (q *Queue[T]) UnmarshalJSON(data []byte) error {
+	return q.dq.UnmarshalJSON(data)
+}
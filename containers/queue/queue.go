@@ -28,12 +28,14 @@ func (q *Queue[T]) Empty() bool {
 }
 
 // Front returns value of the first element in Queue
+// Calling Front on an empty Queue panics with containers.ErrEmpty, see TryFront.
 // Complexity O(1)
 func (q *Queue[T]) Front() T {
 	return q.dq.Front()
 }
 
 // Back returns value of the last element in Queue
+// Calling Back on an empty Queue panics with containers.ErrEmpty, see TryBack.
 // Complexity O(1)
 func (q *Queue[T]) Back() T {
 	return q.dq.Back()
@@ -46,7 +48,36 @@ func (q *Queue[T]) Push(element T) {
 }
 
 // Pop removes and returns first element of Queue
+// Calling Pop on an empty Queue panics with containers.ErrEmpty, see TryPop.
 // Complexity O(1)
 func (q *Queue[T]) Pop() T {
 	return q.dq.PopFront()
 }
+
+// Each calls fn for every element from front to back, that is in the order they would be popped,
+// until fn returns false. The Queue must not be modified during the iteration.
+// Complexity O(n)
+func (q *Queue[T]) Each(fn func(T) bool) {
+	q.dq.Each(fn)
+}
+
+// TryFront returns value of the first element in Queue
+// Returns the zero value of T and false if the Queue is empty.
+// Complexity O(1)
+func (q *Queue[T]) TryFront() (T, bool) {
+	return q.dq.TryFront()
+}
+
+// TryBack returns value of the last element in Queue
+// Returns the zero value of T and false if the Queue is empty.
+// Complexity O(1)
+func (q *Queue[T]) TryBack() (T, bool) {
+	return q.dq.TryBack()
+}
+
+// TryPop removes and returns first element of Queue
+// Returns the zero value of T and false if the Queue is empty.
+// Complexity O(1)
+func (q *Queue[T]) TryPop() (T, bool) {
+	return q.dq.TryPopFront()
+}
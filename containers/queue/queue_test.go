@@ -6,7 +6,11 @@
 package queue
 
 import (
+	"encoding/json"
+	"reflect"
 	"testing"
+
+	"github.com/modern-dev/gtl/funcs"
 )
 
 const queueEnqueuesCount = 300
@@ -83,3 +87,90 @@ func checkQueueSize[T any](queue *Queue[T], expected int, t *testing.T) {
 		t.Errorf("Queue should have size %d but got %d", expected, queue.Size())
 	}
 }
+
+func TestQueueEncoding(t *testing.T) {
+	queue := &Queue[string]{}
+
+	queue.Push("a")
+	queue.Push("b")
+	queue.Push("c")
+
+	js, err := json.Marshal(queue)
+	if err != nil {
+		t.Fatalf("json.Marshal() failed: %v", err)
+	}
+
+	if string(js) != `["a","b","c"]` {
+		t.Errorf("Expected to get %s, got %s", `["a","b","c"]`, js)
+	}
+
+	bin, err := queue.MarshalBinary()
+	if err != nil {
+		t.Fatalf("MarshalBinary() failed: %v", err)
+	}
+
+	fromBin, fromJSON := &Queue[string]{}, &Queue[string]{}
+
+	if err := fromBin.UnmarshalBinary(bin); err != nil {
+		t.Fatalf("UnmarshalBinary() failed: %v", err)
+	}
+
+	if err := json.Unmarshal(js, fromJSON); err != nil {
+		t.Fatalf("json.Unmarshal() failed: %v", err)
+	}
+
+	for _, restored := range []*Queue[string]{fromBin, fromJSON} {
+		checkQueueSize(restored, 3, t)
+
+		if el := restored.Front(); el != "a" {
+			t.Errorf("Expected to get %s, got %s", "a", el)
+		}
+
+		if el := restored.Back(); el != "c" {
+			t.Errorf("Expected to get %s, got %s", "c", el)
+		}
+	}
+}
+
+func TestQueueTryAccessors(t *testing.T) {
+	queue := &Queue[int]{}
+
+	if _, ok := queue.TryPop(); ok {
+		t.Errorf("Expected TryPop() on an empty queue to fail")
+	}
+
+	if _, ok := queue.TryBack(); ok {
+		t.Errorf("Expected TryBack() on an empty queue to fail")
+	}
+
+	queue.Push(1)
+	queue.Push(2)
+
+	if el, ok := queue.TryFront(); !ok || el != 1 {
+		t.Errorf("Expected to get (%d, %t), got (%d, %t)", 1, true, el, ok)
+	}
+
+	if el, ok := queue.TryBack(); !ok || el != 2 {
+		t.Errorf("Expected to get (%d, %t), got (%d, %t)", 2, true, el, ok)
+	}
+
+	if el, ok := queue.TryPop(); !ok || el != 1 {
+		t.Errorf("Expected to get (%d, %t), got (%d, %t)", 1, true, el, ok)
+	}
+
+	checkQueueSize(queue, 1, t)
+}
+
+func TestQueueEach(t *testing.T) {
+	var q Queue[string]
+
+	for _, s := range []string{"a", "b", "c"} {
+		q.Push(s)
+	}
+
+	q.Pop()
+
+	if got, want := funcs.Collect(q.Each), []string{"b", "c"}; !reflect.DeepEqual(got, want) {
+		t.Errorf("Expected to get %v, got %v", want, got)
+	}
+}
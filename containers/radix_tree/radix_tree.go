@@ -0,0 +1,307 @@
+// Copyright 2021. The GTL Authors. All rights reserved.
+// https://github.com/modern-dev/gtl
+// Use of this source code is governed by the MIT
+// license that can be found in the LICENSE file.
+
+package radix_tree
+
+import (
+	"sort"
+	"strings"
+)
+
+type (
+	// Key is a constraint for the types of RadixTree keys.
+	Key interface {
+		~string | ~[]byte
+	}
+
+	// RadixTree is a compressed trie mapping string or byte slice keys to values.
+	// Every edge is labeled with a non-empty byte string and the children of a node are sorted by their labels,
+	// so the keys sharing a prefix form a subtree and are iterated in lexicographic order of bytes.
+	// Byte slice keys are copied on insertion.
+	RadixTree[K Key, V any] struct {
+		root node[V]
+		size int
+	}
+
+	node[V any] struct {
+		label    string
+		children []*node[V]
+		value    V
+		hasValue bool
+	}
+)
+
+// NewRadixTree creates an empty RadixTree.
+func NewRadixTree[K Key, V any]() *RadixTree[K, V] {
+	return &RadixTree[K, V]{}
+}
+
+// Size returns the number of keys in the tree.
+// Complexity - O(1).
+func (t *RadixTree[K, V]) Size() int {
+	return t.size
+}
+
+// Empty checks if there are keys in the tree.
+// Complexity - O(1).
+func (t *RadixTree[K, V]) Empty() bool {
+	return t.size == 0
+}
+
+// Insert stores the value for the key, replacing the previous one.
+// Complexity - O(k), where k is the length of the key.
+func (t *RadixTree[K, V]) Insert(key K, value V) {
+	n, rest := &t.root, string(key)
+
+	for rest != "" {
+		i, found := n.child(rest[0])
+
+		if !found {
+			n.insertChild(i, &node[V]{label: rest, value: value, hasValue: true})
+			t.size++
+
+			return
+		}
+
+		child := n.children[i]
+		common := commonPrefix(rest, child.label)
+
+		if common < len(child.label) {
+			// split the edge, so that the common part leads to a new inner node
+			split := &node[V]{label: child.label[:common], children: []*node[V]{child}}
+			child.label = child.label[common:]
+			n.children[i] = split
+			child = split
+		}
+
+		n, rest = child, rest[common:]
+	}
+
+	if !n.hasValue {
+		t.size++
+	}
+
+	n.value, n.hasValue = value, true
+}
+
+// Get returns the value stored for the key.
+// Returns the zero value of V and false if there is no such key.
+// Complexity - O(k), where k is the length of the key.
+func (t *RadixTree[K, V]) Get(key K) (V, bool) {
+	if n := t.find(string(key)); n != nil && n.hasValue {
+		return n.value, true
+	}
+
+	var emptyVal V
+
+	return emptyVal, false
+}
+
+// Contains checks if the tree holds the key.
+// Complexity - O(k), where k is the length of the key.
+func (t *RadixTree[K, V]) Contains(key K) bool {
+	n := t.find(string(key))
+
+	return n != nil && n.hasValue
+}
+
+// Delete removes the key from the tree and returns true if the tree held it.
+// The nodes left without a value and with a single child are merged with the child.
+// Complexity - O(k), where k is the length of the key.
+func (t *RadixTree[K, V]) Delete(key K) bool {
+	var (
+		parent *node[V]
+		index  int
+	)
+
+	n, rest := &t.root, string(key)
+
+	for rest != "" {
+		i, found := n.child(rest[0])
+
+		if !found || !strings.HasPrefix(rest, n.children[i].label) {
+			return false
+		}
+
+		parent, index = n, i
+		n, rest = n.children[i], rest[len(n.children[i].label):]
+	}
+
+	if !n.hasValue {
+		return false
+	}
+
+	var emptyVal V
+
+	n.value, n.hasValue = emptyVal, false
+	t.size--
+
+	switch {
+	case parent == nil:
+		// the root has no label and is never merged
+	case len(n.children) == 0:
+		parent.children = append(parent.children[:index], parent.children[index+1:]...)
+
+		if parent != &t.root && !parent.hasValue && len(parent.children) == 1 {
+			parent.mergeChild()
+		}
+	case len(n.children) == 1:
+		n.mergeChild()
+	}
+
+	return true
+}
+
+// LongestPrefixMatch returns the longest key in the tree that is a prefix of the given key, and its value.
+// Returns false if no key is a prefix of the given key.
+// Complexity - O(k), where k is the length of the given key.
+func (t *RadixTree[K, V]) LongestPrefixMatch(key K) (K, V, bool) {
+	var (
+		match  *node[V]
+		length int
+	)
+
+	s := string(key)
+	n, rest := &t.root, s
+
+	for {
+		if n.hasValue {
+			match, length = n, len(s)-len(rest)
+		}
+
+		if rest == "" {
+			break
+		}
+
+		i, found := n.child(rest[0])
+
+		if !found || !strings.HasPrefix(rest, n.children[i].label) {
+			break
+		}
+
+		n, rest = n.children[i], rest[len(n.children[i].label):]
+	}
+
+	if match == nil {
+		var (
+			emptyKey K
+			emptyVal V
+		)
+
+		return emptyKey, emptyVal, false
+	}
+
+	return K(s[:length]), match.value, true
+}
+
+// WalkPrefix calls fn for every key starting with the prefix and its value
+// in lexicographic order until fn returns false.
+// The tree must not be modified during the iteration.
+// Complexity - O(k + m), where k is the length of the prefix and m is the total length of the visited keys.
+func (t *RadixTree[K, V]) WalkPrefix(prefix K, fn func(key K, value V) bool) {
+	n, rest, path := &t.root, string(prefix), ""
+
+	for rest != "" {
+		i, found := n.child(rest[0])
+
+		if !found {
+			return
+		}
+
+		child := n.children[i]
+
+		if !strings.HasPrefix(rest, child.label) {
+			// the prefix ends in the middle of the edge
+			if strings.HasPrefix(child.label, rest) {
+				walk(child, path+child.label, fn)
+			}
+
+			return
+		}
+
+		n, rest, path = child, rest[len(child.label):], path+child.label
+	}
+
+	walk(n, path, fn)
+}
+
+// Each calls fn for every key and its value in lexicographic order until fn returns false.
+// The tree must not be modified during the iteration.
+// Complexity - O(m), where m is the total length of the keys.
+func (t *RadixTree[K, V]) Each(fn func(key K, value V) bool) {
+	walk(&t.root, "", fn)
+}
+
+// Clear removes all keys from the tree.
+// Complexity - O(1).
+func (t *RadixTree[K, V]) Clear() {
+	t.root = node[V]{}
+	t.size = 0
+}
+
+func (t *RadixTree[K, V]) find(key string) *node[V] {
+	n := &t.root
+
+	for key != "" {
+		i, found := n.child(key[0])
+
+		if !found || !strings.HasPrefix(key, n.children[i].label) {
+			return nil
+		}
+
+		n, key = n.children[i], key[len(n.children[i].label):]
+	}
+
+	return n
+}
+
+// walk visits the subtree of n in preorder, which is the lexicographic order of the keys.
+func walk[K Key, V any](n *node[V], key string, fn func(K, V) bool) bool {
+	if n.hasValue && !fn(K(key), n.value) {
+		return false
+	}
+
+	for _, child := range n.children {
+		if !walk(child, key+child.label, fn) {
+			return false
+		}
+	}
+
+	return true
+}
+
+// child returns the index of the child whose label starts with the byte,
+// or the index to insert such a child at and false.
+func (n *node[V]) child(b byte) (int, bool) {
+	i := sort.Search(len(n.children), func(i int) bool {
+		return n.children[i].label[0] >= b
+	})
+
+	return i, i < len(n.children) && n.children[i].label[0] == b
+}
+
+func (n *node[V]) insertChild(i int, child *node[V]) {
+	n.children = append(n.children, nil)
+	copy(n.children[i+1:], n.children[i:])
+	n.children[i] = child
+}
+
+// mergeChild joins the node without a value with its single child.
+func (n *node[V]) mergeChild() {
+	child := n.children[0]
+	n.label += child.label
+	n.children = child.children
+	n.value, n.hasValue = child.value, child.hasValue
+}
+
+func commonPrefix(lhs, rhs string) int {
+	i := 0
+
+	for i < len(lhs) && i < len(rhs) && lhs[i] == rhs[i] {
+		i++
+	}
+
+	return i
+}
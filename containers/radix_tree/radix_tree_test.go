@@ -0,0 +1,175 @@
+// Copyright 2021. The GTL Authors. All rights reserved.
+// https://github.com/modern-dev/gtl
+// Use of this source code is governed by the MIT
+// license that can be found in the LICENSE file.
+
+package radix_tree
+
+import (
+	"fmt"
+	"math/rand"
+	"sort"
+	"testing"
+)
+
+func TestRadixTree(t *testing.T) {
+	tree := NewRadixTree[string, int]()
+
+	for i, key := range []string{"romane", "romanus", "romulus", "rubens", "ruber", "rubicon", "rubicundus", "rom", ""} {
+		tree.Insert(key, i)
+	}
+
+	tree.Insert("ruber", 42)
+
+	if tree.Size() != 9 {
+		t.Errorf("Expected size %d, got %d", 9, tree.Size())
+	}
+
+	assertGet(tree, "ruber", 42, true, t)
+	assertGet(tree, "rom", 7, true, t)
+	assertGet(tree, "", 8, true, t)
+	assertGet(tree, "roma", 0, false, t)
+	assertGet(tree, "rubiconx", 0, false, t)
+
+	assertKeys(tree, "", "[ rom romane romanus romulus rubens ruber rubicon rubicundus]", t)
+	assertKeys(tree, "rub", "[rubens ruber rubicon rubicundus]", t)
+	assertKeys(tree, "rubic", "[rubicon rubicundus]", t)
+	assertKeys(tree, "romanus", "[romanus]", t)
+	assertKeys(tree, "rx", "[]", t)
+	assertKeys(tree, "romanusx", "[]", t)
+}
+
+func TestRadixTreeDelete(t *testing.T) {
+	tree := NewRadixTree[string, int]()
+	tree.Insert("team", 1)
+	tree.Insert("test", 2)
+	tree.Insert("te", 3)
+
+	if tree.Delete("t") || tree.Delete("tea") || tree.Delete("teamx") {
+		t.Errorf("Expected Delete() of a missing key to fail")
+	}
+
+	if !tree.Delete("te") || tree.Delete("te") {
+		t.Errorf("Expected Delete() to succeed only once")
+	}
+
+	if !tree.Delete("team") {
+		t.Errorf("Expected Delete() of an existing key to succeed")
+	}
+
+	// the remaining key is compressed into a single edge again
+	if len(tree.root.children) != 1 || tree.root.children[0].label != "test" {
+		t.Errorf("Expected a single edge %q, got %+v", "test", tree.root.children)
+	}
+
+	assertKeys(tree, "", "[test]", t)
+}
+
+func TestLongestPrefixMatch(t *testing.T) {
+	routes := NewRadixTree[[]byte, string]()
+	routes.Insert([]byte("/"), "root")
+	routes.Insert([]byte("/api/"), "api")
+	routes.Insert([]byte("/api/v2/"), "v2")
+
+	for path, expected := range map[string]string{
+		"/index.html":   "/ root",
+		"/api/v1/users": "/api/ api",
+		"/api/v2/users": "/api/v2/ v2",
+		"/api":          "/ root",
+	} {
+		key, value, ok := routes.LongestPrefixMatch([]byte(path))
+
+		if got := fmt.Sprintf("%s %s", key, value); !ok || got != expected {
+			t.Errorf("Expected %s to match (%s, %t), got (%s, %t)", path, expected, true, got, ok)
+		}
+	}
+
+	if _, _, ok := routes.LongestPrefixMatch([]byte("api")); ok {
+		t.Errorf("Expected no match for a relative path")
+	}
+}
+
+func TestRadixTreeAgainstMap(t *testing.T) {
+	rnd := rand.New(rand.NewSource(5))
+	tree := NewRadixTree[string, int]()
+	expected := make(map[string]int)
+
+	for i := 0; i < 20000; i++ {
+		// short keys over a small alphabet share many prefixes
+		key := make([]byte, rnd.Intn(6))
+
+		for j := range key {
+			key[j] = "abc"[rnd.Intn(3)]
+		}
+
+		if rnd.Intn(3) == 0 {
+			_, ok := expected[string(key)]
+
+			if tree.Delete(string(key)) != ok {
+				t.Fatalf("Expected Delete(%q) to return %t", key, ok)
+			}
+
+			delete(expected, string(key))
+		} else {
+			tree.Insert(string(key), i)
+			expected[string(key)] = i
+		}
+	}
+
+	keys := make([]string, 0, len(expected))
+
+	for key := range expected {
+		keys = append(keys, key)
+	}
+
+	sort.Strings(keys)
+	assertKeys(tree, "", fmt.Sprint(keys), t)
+	assertCompressed(&tree.root, true, t)
+
+	for key, value := range expected {
+		assertGet(tree, key, value, true, t)
+	}
+
+	tree.Clear()
+
+	if !tree.Empty() {
+		t.Errorf("Expected tree to be empty after Clear()")
+	}
+}
+
+// assertCompressed checks that every inner node either holds a value or branches.
+func assertCompressed[V any](n *node[V], root bool, t *testing.T) {
+	t.Helper()
+
+	if !root && (n.label == "" || !n.hasValue && len(n.children) < 2) {
+		t.Fatalf("Expected node %q to be merged with its child", n.label)
+	}
+
+	for _, child := range n.children {
+		assertCompressed(child, false, t)
+	}
+}
+
+func assertGet[K Key](tree *RadixTree[K, int], key K, value int, ok bool, t *testing.T) {
+	t.Helper()
+
+	if v, found := tree.Get(key); v != value || found != ok || tree.Contains(key) != ok {
+		t.Errorf("Expected Get(%q) to return (%d, %t), got (%d, %t)", key, value, ok, v, found)
+	}
+}
+
+func assertKeys[K Key, V any](tree *RadixTree[K, V], prefix K, expected string, t *testing.T) {
+	t.Helper()
+
+	var keys []string
+
+	tree.WalkPrefix(prefix, func(key K, _ V) bool {
+		keys = append(keys, string(key))
+
+		return true
+	})
+
+	if got := fmt.Sprint(keys); got != expected {
+		t.Errorf("Expected keys with prefix %q to be %s, got %s", prefix, expected, got)
+	}
+}
@@ -0,0 +1,97 @@
+// Copyright 2021. The GTL Authors. All rights reserved.
+// https://github.com/modern-dev/gtl
+// Use of this source code is governed by the MIT
+// license that can be found in the LICENSE file.
+
+package rbtree
+
+import (
+	"encoding/json"
+	"errors"
+
+	"github.com/modern-dev/gtl/internal/serial"
+)
+
+// binaryVersion is the version of the format produced by MarshalBinary.
+const binaryVersion byte = 1
+
+// ErrNoComparator is returned when decoding into a RBTree that was not created by one of the constructors.
+// The comparator is not a part of the encoded data, so the tree has to be created before decoding.
+var ErrNoComparator = errors.New("rbtree: comparator is not set")
+
+// MarshalBinary implements the encoding.BinaryMarshaler interface.
+// The elements are encoded in ascending order according to the comparator.
+func (rbt *RBTree[T]) MarshalBinary() ([]byte, error) {
+	return serial.Marshal(binaryVersion, rbt.values())
+}
+
+// UnmarshalBinary implements the encoding.BinaryUnmarshaler interface.
+// The current contents of the tree are replaced and every element is reinserted using its comparator.
+func (rbt *RBTree[T]) UnmarshalBinary(data []byte) error {
+	if rbt.ordering == nil {
+		return ErrNoComparator
+	}
+
+	values, err := serial.Unmarshal[[]T](data, binaryVersion)
+
+	if err != nil {
+		return err
+	}
+
+	rbt.assign(values)
+
+	return nil
+}
+
+// MarshalJSON implements the json.Marshaler interface.
+// The tree is encoded as a JSON array in ascending order according to the comparator.
+func (rbt *RBTree[T]) MarshalJSON() ([]byte, error) {
+	return json.Marshal(rbt.values())
+}
+
+// UnmarshalJSON implements the json.Unmarshaler interface.
+// The current contents of the tree are replaced and every element is reinserted using its comparator.
+func (rbt *RBTree[T]) UnmarshalJSON(data []byte) error {
+	if rbt.ordering == nil {
+		return ErrNoComparator
+	}
+
+	var values []T
+
+	if err := json.Unmarshal(data, &values); err != nil {
+		return err
+	}
+
+	rbt.assign(values)
+
+	return nil
+}
+
+func (rbt *RBTree[T]) values() []T {
+	values := make([]T, 0, rbt.size)
+
+	rbt.inorder(rbt.root, func(value T) {
+		values = append(values, value)
+	})
+
+	return values
+}
+
+func (rbt *RBTree[T]) assign(values []T) {
+	rbt.root = rbt.nilNode
+	rbt.size = 0
+
+	for _, value := range values {
+		rbt.Insert(value)
+	}
+}
+
+func (rbt *RBTree[T]) inorder(node *nodeHandle[T], fn func(T)) {
+	if node == rbt.nilNode {
+		return
+	}
+
+	rbt.inorder(node.left, fn)
+	fn(node.value)
+	rbt.inorder(node.right, fn)
+}
@@ -0,0 +1,160 @@
+// Copyright 2021. The GTL Authors. All rights reserved.
+// https://github.com/modern-dev/gtl
+// Use of this source code is governed by the MIT
+// license that can be found in the LICENSE file.
+
+package rbtree
+
+import (
+	"constraints"
+
+	"github.com/modern-dev/gtl/utility"
+)
+
+type (
+	// Interval is a closed interval [Low, High] with an associated value.
+	Interval[K any, V any] struct {
+		Low, High K
+		Value     V
+	}
+
+	// IntervalTree is a red-black tree of intervals ordered by their low endpoints,
+	// where every node is augmented with the greatest high endpoint in its subtree.
+	// It finds all intervals overlapping a given one in O(log n + k), where k is the number of the found intervals.
+	// Equal intervals may be stored several times.
+	IntervalTree[K any, V any] struct {
+		tree     *RBTree[*intervalEntry[K, V]]
+		ordering utility.Ordering[K]
+	}
+
+	intervalEntry[K any, V any] struct {
+		Interval[K, V]
+		maxHigh K
+	}
+)
+
+// NewIntervalTree creates an empty IntervalTree with endpoints in their natural order.
+func NewIntervalTree[K constraints.Ordered, V any]() *IntervalTree[K, V] {
+	return NewIntervalTreeWithOrdering[K, V](utility.Natural[K]())
+}
+
+// NewIntervalTreeWithComparator creates an empty IntervalTree with provided comparator for endpoints.
+func NewIntervalTreeWithComparator[K any, V any](comparator utility.Compare[K]) *IntervalTree[K, V] {
+	return NewIntervalTreeWithOrdering[K, V](utility.ToOrdering(comparator))
+}
+
+// NewIntervalTreeWithOrdering creates an empty IntervalTree with provided three-way comparator for endpoints.
+func NewIntervalTreeWithOrdering[K any, V any](ordering utility.Ordering[K]) *IntervalTree[K, V] {
+	byEndpoints := func(lhs, rhs *intervalEntry[K, V]) int {
+		if res := ordering(lhs.Low, rhs.Low); res != 0 {
+			return res
+		}
+
+		return ordering(lhs.High, rhs.High)
+	}
+
+	it := &IntervalTree[K, V]{
+		tree:     NewRBTreeWithOrdering[*intervalEntry[K, V]](byEndpoints),
+		ordering: ordering,
+	}
+
+	it.tree.augment = it.augment
+
+	return it
+}
+
+// Size returns the number of intervals in the tree.
+// Complexity O(1).
+func (it *IntervalTree[K, V]) Size() int {
+	return it.tree.Size()
+}
+
+// Empty checks if there are intervals in the tree.
+// Complexity O(1).
+func (it *IntervalTree[K, V]) Empty() bool {
+	return it.tree.Empty()
+}
+
+// Insert adds the interval [low, high] with the value. Panics if low is greater than high.
+// Complexity O(log n), where n is the number of intervals in the tree.
+func (it *IntervalTree[K, V]) Insert(low, high K, value V) {
+	if it.ordering(low, high) > 0 {
+		panic("rbtree: interval low endpoint is greater than high endpoint")
+	}
+
+	it.tree.Insert(&intervalEntry[K, V]{Interval: Interval[K, V]{low, high, value}, maxHigh: high})
+}
+
+// Delete removes an interval with exactly the given endpoints and returns true if the tree held one.
+// If there are several such intervals, only one of them is removed.
+// Complexity O(log n), where n is the number of intervals in the tree.
+func (it *IntervalTree[K, V]) Delete(low, high K) bool {
+	node, found := it.tree.searchFromNode(it.tree.root, &intervalEntry[K, V]{Interval: Interval[K, V]{Low: low, High: high}})
+
+	if found {
+		it.tree.eraseNode(node)
+	}
+
+	return found
+}
+
+// Overlapping returns the intervals that share at least one point with [low, high], ordered by their low endpoints.
+// Complexity O(log n + k), where n is the number of intervals in the tree and k is the number of the found ones.
+func (it *IntervalTree[K, V]) Overlapping(low, high K) []Interval[K, V] {
+	var res []Interval[K, V]
+
+	it.overlapping(it.tree.root, low, high, func(i Interval[K, V]) {
+		res = append(res, i)
+	})
+
+	return res
+}
+
+// Stabbing returns the intervals that contain the point, ordered by their low endpoints.
+// Complexity O(log n + k), where n is the number of intervals in the tree and k is the number of the found ones.
+func (it *IntervalTree[K, V]) Stabbing(point K) []Interval[K, V] {
+	return it.Overlapping(point, point)
+}
+
+// Each calls fn for every interval ordered by the low endpoints until fn returns false.
+// The tree must not be modified during the iteration.
+// Complexity O(n), where n is the number of intervals in the tree.
+func (it *IntervalTree[K, V]) Each(fn func(interval Interval[K, V]) bool) {
+	it.tree.Each(func(e *intervalEntry[K, V]) bool {
+		return fn(e.Interval)
+	})
+}
+
+func (it *IntervalTree[K, V]) overlapping(node *nodeHandle[*intervalEntry[K, V]], low, high K,
+	fn func(Interval[K, V])) {
+	// the subtree has nothing that reaches low
+	if node == it.tree.nilNode || it.ordering(node.value.maxHigh, low) < 0 {
+		return
+	}
+
+	it.overlapping(node.left, low, high, fn)
+
+	// the node and its right subtree start after high
+	if it.ordering(node.value.Low, high) > 0 {
+		return
+	}
+
+	if it.ordering(low, node.value.High) <= 0 {
+		fn(node.value.Interval)
+	}
+
+	it.overlapping(node.right, low, high, fn)
+}
+
+// augment recomputes the greatest high endpoint in the subtree of the node.
+func (it *IntervalTree[K, V]) augment(node *nodeHandle[*intervalEntry[K, V]]) {
+	maxHigh := node.value.High
+
+	for _, child := range []*nodeHandle[*intervalEntry[K, V]]{node.left, node.right} {
+		if child != it.tree.nilNode && it.ordering(child.value.maxHigh, maxHigh) > 0 {
+			maxHigh = child.value.maxHigh
+		}
+	}
+
+	node.value.maxHigh = maxHigh
+}
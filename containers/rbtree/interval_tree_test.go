@@ -0,0 +1,153 @@
+// Copyright 2021. The GTL Authors. All rights reserved.
+// https://github.com/modern-dev/gtl
+// Use of this source code is governed by the MIT
+// license that can be found in the LICENSE file.
+
+package rbtree
+
+import (
+	"fmt"
+	"math/rand"
+	"net"
+	"testing"
+
+	"github.com/modern-dev/gtl/utility"
+)
+
+func TestIntervalTree(t *testing.T) {
+	it := NewIntervalTree[int, string]()
+
+	for _, i := range []Interval[int, string]{
+		{15, 20, "a"}, {10, 30, "b"}, {17, 19, "c"}, {5, 20, "d"}, {12, 15, "e"}, {30, 40, "f"}, {12, 15, "g"},
+	} {
+		it.Insert(i.Low, i.High, i.Value)
+	}
+
+	assertIntervals(it.Overlapping(6, 7), "[{5 20 d}]", t)
+	assertIntervals(it.Overlapping(21, 29), "[{10 30 b}]", t)
+	assertIntervals(it.Stabbing(30), "[{10 30 b} {30 40 f}]", t)
+	assertIntervals(it.Stabbing(15), "[{5 20 d} {10 30 b} {12 15 e} {12 15 g} {15 20 a}]", t)
+	assertIntervals(it.Overlapping(41, 50), "[]", t)
+
+	if !it.Delete(12, 15) || !it.Delete(12, 15) || it.Delete(12, 15) || it.Delete(10, 31) {
+		t.Errorf("Expected Delete() to remove both copies of [12, 15] only")
+	}
+
+	assertIntervals(it.Stabbing(15), "[{5 20 d} {10 30 b} {15 20 a}]", t)
+
+	if it.Size() != 5 || it.Empty() {
+		t.Errorf("Expected size %d, got %d", 5, it.Size())
+	}
+}
+
+func TestIntervalTreeWithComparator(t *testing.T) {
+	less := func(lhs, rhs net.IP) bool {
+		return string(lhs.To16()) < string(rhs.To16())
+	}
+	ranges := NewIntervalTreeWithComparator[net.IP, string](utility.CompareFunc[net.IP](less))
+
+	ranges.Insert(net.ParseIP("10.0.0.0"), net.ParseIP("10.255.255.255"), "private")
+	ranges.Insert(net.ParseIP("10.1.0.0"), net.ParseIP("10.1.255.255"), "office")
+	ranges.Insert(net.ParseIP("192.168.0.0"), net.ParseIP("192.168.255.255"), "home")
+
+	var names []string
+
+	for _, r := range ranges.Stabbing(net.ParseIP("10.1.2.3")) {
+		names = append(names, r.Value)
+	}
+
+	if fmt.Sprint(names) != "[private office]" {
+		t.Errorf("Expected to find %s, got %v", "[private office]", names)
+	}
+}
+
+func TestIntervalTreeAgainstBruteForce(t *testing.T) {
+	rnd := rand.New(rand.NewSource(11))
+	it := NewIntervalTree[int, int]()
+
+	var all []Interval[int, int]
+
+	for i := 0; i < 3000; i++ {
+		if len(all) > 0 && rnd.Intn(3) == 0 {
+			j := rnd.Intn(len(all))
+
+			if !it.Delete(all[j].Low, all[j].High) {
+				t.Fatalf("Expected to delete [%d, %d]", all[j].Low, all[j].High)
+			}
+
+			all = append(all[:j], all[j+1:]...)
+		} else {
+			low := rnd.Intn(1000)
+			high := low + rnd.Intn(50)
+
+			it.Insert(low, high, i)
+			all = append(all, Interval[int, int]{low, high, i})
+		}
+
+		if i%100 == 0 {
+			assertAugmented(it, it.tree.root, t)
+		}
+	}
+
+	for q := 0; q < 200; q++ {
+		low := rnd.Intn(1100)
+		high := low + rnd.Intn(30)
+		expected := 0
+
+		for _, i := range all {
+			if i.Low <= high && low <= i.High {
+				expected++
+			}
+		}
+
+		if got := len(it.Overlapping(low, high)); got != expected {
+			t.Fatalf("Expected %d intervals overlapping [%d, %d], got %d", expected, low, high, got)
+		}
+	}
+}
+
+// assertAugmented checks the red-black properties and the max endpoints, and returns the black height.
+func assertAugmented(it *IntervalTree[int, int], node *nodeHandle[*intervalEntry[int, int]], t *testing.T) int {
+	t.Helper()
+
+	if node == it.tree.nilNode {
+		return 1
+	}
+
+	maxHigh := node.value.High
+
+	for _, child := range []*nodeHandle[*intervalEntry[int, int]]{node.left, node.right} {
+		if child != it.tree.nilNode && child.value.maxHigh > maxHigh {
+			maxHigh = child.value.maxHigh
+		}
+
+		if node.col == red && child.col == red {
+			t.Fatalf("Expected red node [%d, %d] to have black children", node.value.Low, node.value.High)
+		}
+	}
+
+	if node.value.maxHigh != maxHigh {
+		t.Fatalf("Expected node [%d, %d] to have max endpoint %d, got %d",
+			node.value.Low, node.value.High, maxHigh, node.value.maxHigh)
+	}
+
+	left, right := assertAugmented(it, node.left, t), assertAugmented(it, node.right, t)
+
+	if left != right {
+		t.Fatalf("Expected equal black heights, got %d and %d", left, right)
+	}
+
+	if node.col == black {
+		left++
+	}
+
+	return left
+}
+
+func assertIntervals[K any, V any](intervals []Interval[K, V], expected string, t *testing.T) {
+	t.Helper()
+
+	if got := fmt.Sprint(intervals); got != expected {
+		t.Errorf("Expected intervals %s, got %s", expected, got)
+	}
+}
@@ -7,6 +7,8 @@ package rbtree
 
 import (
 	"constraints"
+
+	"github.com/modern-dev/gtl/containers"
 	"github.com/modern-dev/gtl/utility"
 )
 
@@ -16,12 +18,14 @@ const (
 )
 
 type (
-	RBTree[T comparable] struct {
-		root    *nodeHandle[T]
-		nilNode *nodeHandle[T]
-		cmpInst utility.Compare[T]
-		size    int
-		dupl    bool
+	RBTree[T any] struct {
+		root     *nodeHandle[T]
+		nilNode  *nodeHandle[T]
+		ordering utility.Ordering[T]
+		size     int
+		// augment recomputes the data a node aggregates from its children, see IntervalTree.
+		// It is called bottom-up for every node whose subtree changes.
+		augment func(node *nodeHandle[T])
 	}
 
 	nodeHandle[T any] struct {
@@ -35,30 +39,39 @@ type (
 	color uint8
 )
 
+// NewRBTree creates an empty tree of ordered items.
+// The allowDuplicates parameter is deprecated and ignored, duplicates are always stored.
 func NewRBTree[T constraints.Ordered](allowDuplicates bool) *RBTree[T] {
-	nilNode := &nodeHandle[T]{col: black}
-
-	return &RBTree[T]{
-		root:    nilNode,
-		nilNode: nilNode,
-		cmpInst: &utility.Less[T]{},
-		dupl:    allowDuplicates,
-	}
+	return NewRBTreeWithOrdering[T](utility.Natural[T]())
 }
 
 // NewRBTreeWithComparator creates an empty tree with provided comparator for items.
-func NewRBTreeWithComparator[T comparable](comparator utility.Compare[T], allowDuplicates bool) *RBTree[T] {
+// Equivalence of items is inferred with two calls to the comparator, see NewRBTreeWithOrdering.
+// The allowDuplicates parameter is deprecated and ignored, duplicates are always stored.
+func NewRBTreeWithComparator[T any](comparator utility.Compare[T], allowDuplicates bool) *RBTree[T] {
+	return NewRBTreeWithOrdering[T](utility.ToOrdering(comparator))
+}
+
+// NewRBTreeWithComparatorFunc creates an empty tree with provided less function for items, see utility.CompareFunc.
+// The allowDuplicates parameter is deprecated and ignored, duplicates are always stored.
+func NewRBTreeWithComparatorFunc[T any](less func(lhs, rhs T) bool, allowDuplicates bool) *RBTree[T] {
+	return NewRBTreeWithComparator[T](utility.CompareFunc[T](less), allowDuplicates)
+}
+
+// NewRBTreeWithOrdering creates an empty tree with provided three-way comparator for items.
+// Two items are considered equal if the comparator returns zero for them. Duplicates are always stored.
+func NewRBTreeWithOrdering[T any](ordering utility.Ordering[T]) *RBTree[T] {
 	nilNode := &nodeHandle[T]{col: black}
 
 	return &RBTree[T]{
-		root:    nilNode,
-		nilNode: nilNode,
-		cmpInst: comparator,
-		dupl:    allowDuplicates,
+		root:     nilNode,
+		nilNode:  nilNode,
+		ordering: ordering,
 	}
 }
 
 // Insert adds value into the tree.
+// Items equal to ones already in the tree are stored too.
 // Complexity O(log n), where n is the number of elements in the tree.
 func (rbt *RBTree[T]) Insert(value T) {
 	newNode := &nodeHandle[T]{
@@ -73,6 +86,7 @@ func (rbt *RBTree[T]) Insert(value T) {
 	newNode.parent = parentNode
 
 	rbt.addChild(parentNode, newNode)
+	rbt.updatePath(newNode)
 
 	rbt.size++
 
@@ -91,21 +105,57 @@ func (rbt *RBTree[T]) Find(value T) (T, bool) {
 }
 
 // Max returns max item in the tree according to the comparator.
+// Calling Max on an empty tree panics with containers.ErrEmpty, see TryMax.
 // Complexity O(log n), where n is the number of elements in tree.
 func (rbt *RBTree[T]) Max() T {
+	if rbt.Empty() {
+		panic(containers.ErrEmpty)
+	}
+
 	node := rbt.maximum(rbt.root)
 
 	return node.value
 }
 
 // Min returns min item in the tree according to the comparator
+// Calling Min on an empty tree panics with containers.ErrEmpty, see TryMin.
 // Complexity O(log n), where n is the number of elements in the tree.
 func (rbt *RBTree[T]) Min() T {
+	if rbt.Empty() {
+		panic(containers.ErrEmpty)
+	}
+
 	node := rbt.minimum(rbt.root)
 
 	return node.value
 }
 
+// TryMax returns max item in the tree according to the comparator.
+// Returns the zero value of T and false if the tree is empty.
+// Complexity O(log n), where n is the number of elements in tree.
+func (rbt *RBTree[T]) TryMax() (T, bool) {
+	if rbt.Empty() {
+		var emptyEl T
+
+		return emptyEl, false
+	}
+
+	return rbt.Max(), true
+}
+
+// TryMin returns min item in the tree according to the comparator.
+// Returns the zero value of T and false if the tree is empty.
+// Complexity O(log n), where n is the number of elements in the tree.
+func (rbt *RBTree[T]) TryMin() (T, bool) {
+	if rbt.Empty() {
+		var emptyEl T
+
+		return emptyEl, false
+	}
+
+	return rbt.Min(), true
+}
+
 // Erase deletes the item from the tree. Has no effect if the item was not in the tree.
 // Complexity O(log n), where n is the number of elements in the tree.
 func (rbt *RBTree[T]) Erase(value T) {
@@ -115,11 +165,7 @@ func (rbt *RBTree[T]) Erase(value T) {
 		return
 	}
 
-	if color, nodeToFix := rbt.deleteNode(node); color == black {
-		rbt.deleteFixup(nodeToFix)
-	}
-
-	rbt.size--
+	rbt.eraseNode(node)
 }
 
 // Size return the number of elements in the tree.
@@ -132,18 +178,81 @@ func (rbt *RBTree[T]) Empty() bool {
 	return rbt.Size() == 0
 }
 
+// Each calls fn for every item of the tree in ascending order until fn returns false.
+// The tree must not be modified during the iteration.
+// Complexity O(n), where n is the number of elements in the tree.
+func (rbt *RBTree[T]) Each(fn func(value T) bool) {
+	rbt.each(rbt.root, fn)
+}
+
+// Range calls fn for every item in the half-open interval [from, to) in ascending order until fn returns false.
+// Subtrees outside the interval are not visited. The tree must not be modified during the iteration.
+// Complexity O(log n + k), where n is the number of elements in the tree and k is the number of visited items.
+func (rbt *RBTree[T]) Range(from, to T, fn func(value T) bool) {
+	rbt.eachRange(rbt.root, from, to, fn)
+}
+
+func (rbt *RBTree[T]) eachRange(node *nodeHandle[T], from, to T, fn func(T) bool) bool {
+	if node == rbt.nilNode {
+		return true
+	}
+
+	afterFrom, beforeTo := rbt.ordering(node.value, from) >= 0, rbt.ordering(node.value, to) < 0
+
+	if afterFrom && !rbt.eachRange(node.left, from, to, fn) {
+		return false
+	}
+
+	if afterFrom && beforeTo && !fn(node.value) {
+		return false
+	}
+
+	return !beforeTo || rbt.eachRange(node.right, from, to, fn)
+}
+
+func (rbt *RBTree[T]) each(node *nodeHandle[T], fn func(T) bool) bool {
+	if node == rbt.nilNode {
+		return true
+	}
+
+	return rbt.each(node.left, fn) && fn(node.value) && rbt.each(node.right, fn)
+}
+
+func (rbt *RBTree[T]) eraseNode(node *nodeHandle[T]) {
+	color, nodeToFix := rbt.deleteNode(node)
+
+	// the parent of nodeToFix is the lowest node whose subtree has changed, even if nodeToFix is nilNode
+	rbt.updatePath(nodeToFix.parent)
+
+	if color == black {
+		rbt.deleteFixup(nodeToFix)
+	}
+
+	rbt.size--
+}
+
+// updatePath calls augment for the node and all its ancestors.
+func (rbt *RBTree[T]) updatePath(node *nodeHandle[T]) {
+	if rbt.augment == nil {
+		return
+	}
+
+	for ; node != rbt.nilNode; node = node.parent {
+		rbt.augment(node)
+	}
+}
+
 func (rbt *RBTree[T]) searchFromNode(node *nodeHandle[T], value T) (*nodeHandle[T], bool) {
 	it := node
 
 	for it != rbt.nilNode {
-		cmpRes := rbt.cmpInst.Cmp(value, it.value)
+		cmpRes := rbt.ordering(value, it.value)
 
-		// discard duplicates
-		if value == it.value && !rbt.dupl {
+		if cmpRes == 0 {
 			return it, true
 		}
 
-		if cmpRes {
+		if cmpRes < 0 {
 			it = it.left
 		} else {
 			it = it.right
@@ -158,7 +267,7 @@ func (rbt *RBTree[T]) findInsertNode(newNode *nodeHandle[T]) *nodeHandle[T] {
 
 	for x != rbt.nilNode {
 		y = x
-		if rbt.cmpInst.Cmp(newNode.value, x.value) {
+		if rbt.ordering(newNode.value, x.value) < 0 {
 			x = x.left
 		} else {
 			x = x.right
@@ -175,7 +284,7 @@ func (rbt *RBTree[T]) addChild(node, child *nodeHandle[T]) {
 		return
 	}
 
-	if rbt.cmpInst.Cmp(child.value, node.value) {
+	if rbt.ordering(child.value, node.value) < 0 {
 		node.left = child
 
 		return
@@ -398,6 +507,8 @@ func (rbt *RBTree[T]) leftRotate(x *nodeHandle[T]) {
 
 	y.left = x
 	x.parent = y
+
+	rbt.updateRotated(x, y)
 }
 
 func (rbt *RBTree[T]) rightRotate(x *nodeHandle[T]) {
@@ -420,4 +531,14 @@ func (rbt *RBTree[T]) rightRotate(x *nodeHandle[T]) {
 
 	y.right = x
 	x.parent = y
+
+	rbt.updateRotated(x, y)
+}
+
+// updateRotated calls augment for the nodes of a rotation, where x became the child of y.
+func (rbt *RBTree[T]) updateRotated(x, y *nodeHandle[T]) {
+	if rbt.augment != nil {
+		rbt.augment(x)
+		rbt.augment(y)
+	}
 }
@@ -7,7 +7,10 @@ package rbtree
 
 import (
 	"constraints"
+	"fmt"
 	"testing"
+
+	"github.com/modern-dev/gtl/utility"
 )
 
 func TestNewTree(t *testing.T) {
@@ -31,6 +34,21 @@ func TestTreeLen(t *testing.T) {
 	assertTreeSize(tree, len(items), t)
 }
 
+func TestTreeDuplicates(t *testing.T) {
+	// allowDuplicates is ignored, equal items are always stored
+	for _, tree := range []*RBTree[int]{NewRBTree[int](false), NewRBTree[int](true)} {
+		for _, item := range []int{3, 1, 3, 2, 1, 3} {
+			tree.Insert(item)
+		}
+
+		assertTreeSize(tree, 6, t)
+
+		tree.Erase(3)
+		assertTreeValueSearch(tree, 3, true, t)
+		assertTreeSize(tree, 5, t)
+	}
+}
+
 func TestTreeSearch(t *testing.T) {
 	runTreeSearch[int]([]int{5, 3, 1, 2, 4, 12, 10, 42, 13}, []int{0, 6, 11, 17, 18}, t)
 
@@ -126,7 +144,7 @@ func runTreeSearch[T constraints.Ordered](existingElements, notExistingElements
 	}
 }
 
-func runTreeDelete[T comparable](tree *RBTree[T], cases []struct {
+func runTreeDelete[T any](tree *RBTree[T], cases []struct {
 	item                   T
 	shouldExistAfterDelete bool
 	size                   int
@@ -148,14 +166,190 @@ func treeFromSlice[T constraints.Ordered](elements []T) *RBTree[T] {
 	return tree
 }
 
-func assertTreeSize[T comparable](tree *RBTree[T], expected int, t *testing.T) {
+func assertTreeSize[T any](tree *RBTree[T], expected int, t *testing.T) {
 	if expected != tree.Size() {
 		t.Errorf("Expected tree Len() to be %d, got %d", expected, tree.Size())
 	}
 }
 
-func assertTreeValueSearch[T comparable](tree *RBTree[T], value T, expected bool, t *testing.T) {
+func assertTreeValueSearch[T any](tree *RBTree[T], value T, expected bool, t *testing.T) {
 	if _, exist := tree.Find(value); exist != expected {
 		t.Errorf("Search test failed for element %v. Got %v, expected %v", value, exist, expected)
 	}
 }
+
+func TestTreeEncoding(t *testing.T) {
+	items := []int{5, 3, 1, 2, 4, 12, 10, 42, 13}
+	tree := treeFromSlice[int](items)
+
+	bin, err := tree.MarshalBinary()
+	if err != nil {
+		t.Fatalf("MarshalBinary() failed: %v", err)
+	}
+
+	js, err := tree.MarshalJSON()
+	if err != nil {
+		t.Fatalf("MarshalJSON() failed: %v", err)
+	}
+
+	if want := "[1,2,3,4,5,10,12,13,42]"; string(js) != want {
+		t.Errorf("Expected MarshalJSON() to return %s, got %s", want, js)
+	}
+
+	fromBin, fromJSON := NewRBTree[int](false), NewRBTree[int](false)
+	fromJSON.Insert(100)
+
+	if err := fromBin.UnmarshalBinary(bin); err != nil {
+		t.Fatalf("UnmarshalBinary() failed: %v", err)
+	}
+
+	if err := fromJSON.UnmarshalJSON(js); err != nil {
+		t.Fatalf("UnmarshalJSON() failed: %v", err)
+	}
+
+	for _, restored := range []*RBTree[int]{fromBin, fromJSON} {
+		assertTreeSize(restored, len(items), t)
+
+		for _, item := range items {
+			assertTreeValueSearch(restored, item, true, t)
+		}
+
+		if restored.Min() != 1 || restored.Max() != 42 {
+			t.Errorf("Expected restored tree to span [1, 42], got [%v, %v]", restored.Min(), restored.Max())
+		}
+	}
+
+	var zero RBTree[int]
+
+	if err := zero.UnmarshalBinary(bin); err != ErrNoComparator {
+		t.Errorf("Expected UnmarshalBinary() to fail with %v, got %v", ErrNoComparator, err)
+	}
+}
+
+func TestTreeWithOrdering(t *testing.T) {
+	type entry struct {
+		key   string
+		value []int
+	}
+
+	tree := NewRBTreeWithOrdering[entry](utility.ByKey(func(e entry) string { return e.key }, utility.CaseInsensitive()))
+
+	for _, key := range []string{"b", "A", "c", "B", "a"} {
+		tree.Insert(entry{key, []int{len(key)}})
+	}
+
+	assertTreeSize(tree, 5, t)
+
+	for _, key := range []string{"a", "B", "C"} {
+		assertTreeValueSearch(tree, entry{key: key}, true, t)
+	}
+
+	tree.Erase(entry{key: "a"})
+	assertTreeValueSearch(tree, entry{key: "a"}, true, t)
+	tree.Erase(entry{key: "a"})
+	assertTreeValueSearch(tree, entry{key: "a"}, false, t)
+	assertTreeSize(tree, 3, t)
+}
+
+func TestTreeWithComparatorFunc(t *testing.T) {
+	tree := NewRBTreeWithComparatorFunc(func(lhs, rhs int) bool { return lhs > rhs }, false)
+
+	for _, item := range []int{5, 3, 1, 2, 4, 12, 10, 42, 13} {
+		tree.Insert(item)
+	}
+
+	if tree.Min() != 42 || tree.Max() != 1 {
+		t.Errorf("Expected reversed tree to span [42, 1], got [%v, %v]", tree.Min(), tree.Max())
+	}
+
+	assertTreeValueSearch(tree, 12, true, t)
+	assertTreeValueSearch(tree, 11, false, t)
+}
+
+func TestTreeTryMinMax(t *testing.T) {
+	tree := NewRBTree[int](false)
+
+	if _, ok := tree.TryMin(); ok {
+		t.Errorf("Expected TryMin() on an empty tree to fail")
+	}
+
+	if _, ok := tree.TryMax(); ok {
+		t.Errorf("Expected TryMax() on an empty tree to fail")
+	}
+
+	tree.Insert(2)
+	tree.Insert(1)
+
+	if el, ok := tree.TryMin(); !ok || el != 1 {
+		t.Errorf("Expected to get (%d, %t), got (%d, %t)", 1, true, el, ok)
+	}
+
+	if el, ok := tree.TryMax(); !ok || el != 2 {
+		t.Errorf("Expected to get (%d, %t), got (%d, %t)", 2, true, el, ok)
+	}
+}
+
+func TestTreeEach(t *testing.T) {
+	tree := treeFromSlice[int]([]int{5, 3, 1, 2, 4, 12, 10, 42, 13})
+	expected := []int{1, 2, 3, 4, 5, 10, 12, 13, 42}
+
+	var visited []int
+
+	tree.Each(func(value int) bool {
+		visited = append(visited, value)
+
+		return true
+	})
+
+	if len(visited) != len(expected) {
+		t.Fatalf("Expected to visit %v, got %v", expected, visited)
+	}
+
+	for i := range expected {
+		if visited[i] != expected[i] {
+			t.Fatalf("Expected to visit %v, got %v", expected, visited)
+		}
+	}
+
+	visited = visited[:0]
+
+	tree.Each(func(value int) bool {
+		visited = append(visited, value)
+
+		return value < 4
+	})
+
+	if len(visited) != 4 {
+		t.Errorf("Expected iteration to stop after %d items, got %v", 4, visited)
+	}
+}
+
+func TestTreeRange(t *testing.T) {
+	tree := NewRBTree[int](true)
+
+	for _, el := range []int{5, 3, 1, 2, 4, 12, 10, 42, 13, 4, 10} {
+		tree.Insert(el)
+	}
+
+	var visited []int
+
+	collect := func(value int) bool {
+		visited = append(visited, value)
+
+		return true
+	}
+
+	tree.Range(4, 13, collect)
+
+	if fmt.Sprint(visited) != "[4 4 5 10 10 12]" {
+		t.Errorf("Expected to visit %s, got %v", "[4 4 5 10 10 12]", visited)
+	}
+
+	visited = visited[:0]
+	tree.Range(13, 4, collect)
+	tree.Range(43, 50, collect)
+
+	if len(visited) != 0 {
+		t.Errorf("Expected to visit nothing, got %v", visited)
+	}
+}
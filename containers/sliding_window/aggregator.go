@@ -0,0 +1,133 @@
+// Copyright 2021. The GTL Authors. All rights reserved.
+// https://github.com/modern-dev/gtl
+// Use of this source code is governed by the MIT
+// license that can be found in the LICENSE file.
+
+package sliding_window
+
+import (
+	"github.com/modern-dev/gtl/containers"
+	. "github.com/modern-dev/gtl/containers/stack"
+)
+
+type (
+	// Aggregator is a FIFO sliding window that provides amortized constant time lookup of the aggregate
+	// of its elements under an associative operation, e.g. sum, product, gcd or function composition.
+	// The operation does not have to be commutative or invertible: the aggregate is always folded
+	// from the oldest to the newest element.
+	// The window is kept in two stacks, the newly pushed elements go into the back stack and are moved
+	// to the front stack in bulk when the oldest element is popped. Every element of the front stack
+	// remembers the aggregate of itself and the newer elements of the front stack.
+	Aggregator[T any] struct {
+		front   *Stack[aggregated[T]]
+		back    *Stack[T]
+		backAgg T
+		op      func(T, T) T
+	}
+
+	// aggregated is an element of the front stack of Aggregator along with its partial aggregate.
+	aggregated[T any] struct {
+		value T
+		agg   T
+	}
+)
+
+// NewAggregator creates an empty Aggregator for the given associative operation.
+func NewAggregator[T any](op func(lhs, rhs T) T) *Aggregator[T] {
+	return &Aggregator[T]{
+		front: NewStack[aggregated[T]](),
+		back:  NewStack[T](),
+		op:    op,
+	}
+}
+
+// Size returns the number of elements in the window.
+// Complexity - O(1).
+func (a *Aggregator[T]) Size() int {
+	return a.front.Size() + a.back.Size()
+}
+
+// Empty checks if the window has no elements.
+// Complexity - O(1).
+func (a *Aggregator[T]) Empty() bool {
+	return a.Size() == 0
+}
+
+// Push appends the given element to the window.
+// Complexity - O(1).
+func (a *Aggregator[T]) Push(value T) {
+	if a.back.Empty() {
+		a.backAgg = value
+	} else {
+		a.backAgg = a.op(a.backAgg, value)
+	}
+
+	a.back.Push(value)
+}
+
+// Pop removes and returns the oldest element of the window.
+// Calling Pop on an empty window panics with containers.ErrEmpty, see TryPop.
+// Complexity - amortized O(1).
+func (a *Aggregator[T]) Pop() T {
+	if a.front.Empty() {
+		a.flip()
+	}
+
+	return a.front.Pop().value
+}
+
+// TryPop removes and returns the oldest element of the window.
+// Returns the zero value of T and false if the window is empty.
+// Complexity - amortized O(1).
+func (a *Aggregator[T]) TryPop() (T, bool) {
+	if a.Empty() {
+		var emptyEl T
+
+		return emptyEl, false
+	}
+
+	return a.Pop(), true
+}
+
+// Query returns the aggregate of the elements in the window folded from the oldest to the newest one.
+// Calling Query on an empty window panics with containers.ErrEmpty, see TryQuery.
+// Complexity - O(1).
+func (a *Aggregator[T]) Query() T {
+	switch {
+	case a.Empty():
+		panic(containers.ErrEmpty)
+	case a.front.Empty():
+		return a.backAgg
+	case a.back.Empty():
+		return a.front.Top().agg
+	default:
+		return a.op(a.front.Top().agg, a.backAgg)
+	}
+}
+
+// TryQuery returns the aggregate of the elements in the window folded from the oldest to the newest one.
+// Returns the zero value of T and false if the window is empty.
+// Complexity - O(1).
+func (a *Aggregator[T]) TryQuery() (T, bool) {
+	if a.Empty() {
+		var emptyEl T
+
+		return emptyEl, false
+	}
+
+	return a.Query(), true
+}
+
+// flip moves all elements of the back stack to the front stack, computing their partial aggregates.
+func (a *Aggregator[T]) flip() {
+	for !a.back.Empty() {
+		value := a.back.Pop()
+		agg := value
+
+		if top, ok := a.front.TryTop(); ok {
+			agg = a.op(value, top.agg)
+		}
+
+		a.front.Push(aggregated[T]{value, agg})
+	}
+}
@@ -0,0 +1,63 @@
+// Copyright 2021. The GTL Authors. All rights reserved.
+// https://github.com/modern-dev/gtl
+// Use of this source code is governed by the MIT
+// license that can be found in the LICENSE file.
+
+package sliding_window
+
+import (
+	"strings"
+	"testing"
+)
+
+func TestAggregatorSum(t *testing.T) {
+	const window = 5
+
+	a := NewAggregator(func(lhs, rhs int) int { return lhs + rhs })
+
+	for i := 1; i <= 100; i++ {
+		a.Push(i)
+
+		if a.Size() > window {
+			a.Pop()
+		}
+
+		lo := max(1, i-window+1)
+
+		if expected := (lo + i) * (i - lo + 1) / 2; a.Query() != expected {
+			t.Fatalf("Expected sum of [%d, %d] to be %d, got %d", lo, i, expected, a.Query())
+		}
+	}
+}
+
+func TestAggregatorNonCommutative(t *testing.T) {
+	a := NewAggregator(func(lhs, rhs string) string { return lhs + rhs })
+	letters := strings.Split("abcdefghij", "")
+
+	if _, ok := a.TryQuery(); ok {
+		t.Errorf("Expected TryQuery() on an empty window to fail")
+	}
+
+	for i, letter := range letters {
+		a.Push(letter)
+
+		if i%3 == 2 {
+			a.Pop()
+		}
+	}
+
+	// three elements were popped from the front
+	if expected := "defghij"; a.Query() != expected {
+		t.Errorf("Expected aggregate %s, got %s", expected, a.Query())
+	}
+
+	for i := 3; i < len(letters); i++ {
+		if el, ok := a.TryPop(); !ok || el != letters[i] {
+			t.Errorf("Expected to get (%s, %t), got (%s, %t)", letters[i], true, el, ok)
+		}
+	}
+
+	if _, ok := a.TryPop(); ok {
+		t.Errorf("Expected TryPop() on an empty window to fail")
+	}
+}
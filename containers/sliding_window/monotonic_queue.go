@@ -0,0 +1,160 @@
+// Copyright 2021. The GTL Authors. All rights reserved.
+// https://github.com/modern-dev/gtl
+// Use of this source code is governed by the MIT
+// license that can be found in the LICENSE file.
+
+// Package sliding_window provides containers computing aggregates over a sliding window of a stream.
+package sliding_window
+
+import (
+	"constraints"
+
+	"github.com/modern-dev/gtl/containers"
+	. "github.com/modern-dev/gtl/containers/deque"
+	"github.com/modern-dev/gtl/utility"
+)
+
+type (
+	// MonotonicQueue is a sliding window over a stream that provides amortized constant time lookup
+	// of the smallest and the largest element in the window.
+	// Every pushed element gets a position, which is the number of elements pushed before it,
+	// and the window is advanced by evicting the elements with positions below a bound.
+	// The candidates for the extremes are kept in two Deques ordered monotonically from front to back.
+	MonotonicQueue[T any] struct {
+		minDq   *Deque[positioned[T]]
+		maxDq   *Deque[positioned[T]]
+		next    int
+		start   int
+		cmpInst utility.Compare[T]
+	}
+
+	// positioned is an element of MonotonicQueue tagged with its position in the stream.
+	positioned[T any] struct {
+		value T
+		pos   int
+	}
+)
+
+// NewMonotonicQueue creates an empty MonotonicQueue.
+func NewMonotonicQueue[T constraints.Ordered]() *MonotonicQueue[T] {
+	return NewMonotonicQueueWithComparator[T](&utility.Less[T]{})
+}
+
+// NewMonotonicQueueWithComparator creates an empty MonotonicQueue.
+// A utility.Compare type providing a strict weak ordering.
+func NewMonotonicQueueWithComparator[T any](comparator utility.Compare[T]) *MonotonicQueue[T] {
+	return &MonotonicQueue[T]{
+		minDq:   NewDeque[positioned[T]](),
+		maxDq:   NewDeque[positioned[T]](),
+		cmpInst: comparator,
+	}
+}
+
+// NewMonotonicQueueWithComparatorFunc creates an empty MonotonicQueue.
+// A less function providing a strict weak ordering, see utility.CompareFunc.
+func NewMonotonicQueueWithComparatorFunc[T any](less func(lhs, rhs T) bool) *MonotonicQueue[T] {
+	return NewMonotonicQueueWithComparator[T](utility.CompareFunc[T](less))
+}
+
+// NewMonotonicQueueWithOrdering creates an empty MonotonicQueue.
+// A utility.Ordering three-way comparator providing a strict weak ordering.
+func NewMonotonicQueueWithOrdering[T any](ordering utility.Ordering[T]) *MonotonicQueue[T] {
+	return NewMonotonicQueueWithComparator[T](utility.ToCompare(ordering))
+}
+
+// Size returns the number of elements in the window.
+// Complexity - O(1).
+func (q *MonotonicQueue[T]) Size() int {
+	return q.next - q.start
+}
+
+// Empty checks if the window has no elements.
+// Complexity - O(1).
+func (q *MonotonicQueue[T]) Empty() bool {
+	return q.Size() == 0
+}
+
+// Push appends the given element to the window.
+// Returns the position of the element, which is the number of elements pushed before it.
+// Complexity - amortized O(1).
+func (q *MonotonicQueue[T]) Push(value T) int {
+	pos := q.next
+	q.next++
+
+	for !q.minDq.Empty() && !q.cmpInst.Cmp(q.minDq.Back().value, value) {
+		q.minDq.PopBack()
+	}
+
+	for !q.maxDq.Empty() && !q.cmpInst.Cmp(value, q.maxDq.Back().value) {
+		q.maxDq.PopBack()
+	}
+
+	q.minDq.PushBack(positioned[T]{value, pos})
+	q.maxDq.PushBack(positioned[T]{value, pos})
+
+	return pos
+}
+
+// Evict removes the elements with positions less than olderThan from the window.
+// Complexity - amortized O(1).
+func (q *MonotonicQueue[T]) Evict(olderThan int) {
+	if olderThan > q.next {
+		olderThan = q.next
+	}
+
+	if olderThan <= q.start {
+		return
+	}
+
+	q.start = olderThan
+
+	for !q.minDq.Empty() && q.minDq.Front().pos < olderThan {
+		q.minDq.PopFront()
+	}
+
+	for !q.maxDq.Empty() && q.maxDq.Front().pos < olderThan {
+		q.maxDq.PopFront()
+	}
+}
+
+// Min returns the smallest element in the window according to the comparator.
+// Of several equivalent smallest elements the most recently pushed one is returned.
+// Calling Min on an empty window panics with containers.ErrEmpty, see TryMin.
+// Complexity - O(1).
+func (q *MonotonicQueue[T]) Min() T {
+	if q.Empty() {
+		panic(containers.ErrEmpty)
+	}
+
+	return q.minDq.Front().value
+}
+
+// Max returns the largest element in the window according to the comparator.
+// Of several equivalent largest elements the most recently pushed one is returned.
+// Calling Max on an empty window panics with containers.ErrEmpty, see TryMax.
+// Complexity - O(1).
+func (q *MonotonicQueue[T]) Max() T {
+	if q.Empty() {
+		panic(containers.ErrEmpty)
+	}
+
+	return q.maxDq.Front().value
+}
+
+// TryMin returns the smallest element in the window according to the comparator.
+// Returns the zero value of T and false if the window is empty.
+// Complexity - O(1).
+func (q *MonotonicQueue[T]) TryMin() (T, bool) {
+	entry, ok := q.minDq.TryFront()
+
+	return entry.value, ok
+}
+
+// TryMax returns the largest element in the window according to the comparator.
+// Returns the zero value of T and false if the window is empty.
+// Complexity - O(1).
+func (q *MonotonicQueue[T]) TryMax() (T, bool) {
+	entry, ok := q.maxDq.TryFront()
+
+	return entry.value, ok
+}
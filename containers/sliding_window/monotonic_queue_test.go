@@ -0,0 +1,95 @@
+// Copyright 2021. The GTL Authors. All rights reserved.
+// https://github.com/modern-dev/gtl
+// Use of this source code is governed by the MIT
+// license that can be found in the LICENSE file.
+
+package sliding_window
+
+import (
+	"math/rand"
+	"testing"
+
+	"github.com/modern-dev/gtl/utility"
+)
+
+func TestMonotonicQueue(t *testing.T) {
+	const window = 7
+
+	rnd := rand.New(rand.NewSource(42))
+	q := NewMonotonicQueue[int]()
+	items := make([]int, 500)
+
+	if _, ok := q.TryMin(); ok {
+		t.Errorf("Expected TryMin() on an empty window to fail")
+	}
+
+	for i := range items {
+		items[i] = rnd.Intn(100)
+
+		if pos := q.Push(items[i]); pos != i {
+			t.Fatalf("Expected Push() to return position %d, got %d", i, pos)
+		}
+
+		q.Evict(i - window + 1)
+
+		lo, hi := items[i], items[i]
+
+		for j := i; j >= 0 && j > i-window; j-- {
+			lo, hi = min(lo, items[j]), max(hi, items[j])
+		}
+
+		if q.Min() != lo || q.Max() != hi {
+			t.Fatalf("Window ending at %d: expected extremes [%d, %d], got [%d, %d]", i, lo, hi, q.Min(), q.Max())
+		}
+
+		if expected := min(i+1, window); q.Size() != expected {
+			t.Fatalf("Expected window size %d, got %d", expected, q.Size())
+		}
+	}
+
+	q.Evict(len(items))
+
+	if !q.Empty() {
+		t.Errorf("Expected window to be empty, got size %d", q.Size())
+	}
+}
+
+func TestMonotonicQueueWithOrdering(t *testing.T) {
+	q := NewMonotonicQueueWithOrdering[string](utility.CaseInsensitive())
+	byLength := NewMonotonicQueueWithComparatorFunc(func(lhs, rhs string) bool { return len(lhs) < len(rhs) })
+
+	for _, v := range []string{"delta", "Alpha", "bb", "Charlie"} {
+		q.Push(v)
+		byLength.Push(v)
+	}
+
+	if q.Min() != "Alpha" || q.Max() != "delta" {
+		t.Errorf("Expected extremes [%v, %v], got [%v, %v]", "Alpha", "delta", q.Min(), q.Max())
+	}
+
+	if byLength.Min() != "bb" || byLength.Max() != "Charlie" {
+		t.Errorf("Expected extremes [%v, %v], got [%v, %v]", "bb", "Charlie", byLength.Min(), byLength.Max())
+	}
+
+	q.Evict(2)
+
+	if q.Min() != "bb" || q.Max() != "Charlie" {
+		t.Errorf("Expected extremes [%v, %v], got [%v, %v]", "bb", "Charlie", q.Min(), q.Max())
+	}
+}
+
+func min(a, b int) int {
+	if a < b {
+		return a
+	}
+
+	return b
+}
+
+func max(a, b int) int {
+	if a > b {
+		return a
+	}
+
+	return b
+}
@@ -0,0 +1,240 @@
+// Copyright 2021. The GTL Authors. All rights reserved.
+// https://github.com/modern-dev/gtl
+// Use of this source code is governed by the MIT
+// license that can be found in the LICENSE file.
+
+package sparse_table
+
+import (
+	"fmt"
+	"math/bits"
+)
+
+type (
+	// EulerTourLCA answers lowest common ancestor queries in constant time
+	// with a range minimum query over the depths along the Euler tour of the tree.
+	EulerTourLCA struct {
+		forest
+		// first[v] is the position of the first occurrence of v in the tour
+		first []int
+		// tour is the sparse table over the tour selecting the shallowest vertex
+		tour *SparseTable[int]
+	}
+
+	// BinaryLiftingLCA answers lowest common ancestor queries in logarithmic time
+	// jumping over the ancestors at power of two distances. It also finds the k-th ancestor of a vertex.
+	BinaryLiftingLCA struct {
+		forest
+		// up[k][v] is the 2^k-th ancestor of v or -1
+		up [][]int
+	}
+
+	// forest is a rooted forest given by the parents of the vertices.
+	forest struct {
+		parent   []int
+		children [][]int
+		depth    []int
+		root     []int
+	}
+)
+
+// NewEulerTourLCA prepares the lowest common ancestor queries for the rooted forest
+// where parent[v] is the parent of the vertex v or -1 for a root.
+// Panics if the parents form a cycle.
+// Complexity - O(n log n).
+func NewEulerTourLCA(parent []int) *EulerTourLCA {
+	lca := &EulerTourLCA{forest: newForest(parent), first: make([]int, len(parent))}
+
+	tour := make([]int, 0, 2*len(parent))
+
+	for v, p := range parent {
+		if p < 0 {
+			tour = lca.appendTour(tour, v)
+		}
+	}
+
+	lca.tour = NewSparseTable(tour, func(lhs, rhs int) int {
+		if lca.depth[rhs] < lca.depth[lhs] {
+			return rhs
+		}
+
+		return lhs
+	})
+
+	return lca
+}
+
+// Query returns the lowest common ancestor of the vertices or -1 if they belong to different trees.
+// Complexity - O(1).
+func (lca *EulerTourLCA) Query(u, v int) int {
+	lca.mustHaveVertex(u)
+	lca.mustHaveVertex(v)
+
+	if lca.root[u] != lca.root[v] {
+		return -1
+	}
+
+	from, to := lca.first[u], lca.first[v]
+
+	if from > to {
+		from, to = to, from
+	}
+
+	return lca.tour.Query(from, to+1)
+}
+
+// appendTour appends the Euler tour of the subtree of the root without recursion,
+// so that deep trees do not grow the stack.
+func (lca *EulerTourLCA) appendTour(tour []int, root int) []int {
+	type frame struct {
+		v, next int
+	}
+
+	stack := []frame{{root, 0}}
+	lca.first[root] = len(tour)
+	tour = append(tour, root)
+
+	for len(stack) > 0 {
+		top := &stack[len(stack)-1]
+
+		if top.next == len(lca.children[top.v]) {
+			stack = stack[:len(stack)-1]
+
+			if len(stack) > 0 {
+				tour = append(tour, stack[len(stack)-1].v)
+			}
+
+			continue
+		}
+
+		child := lca.children[top.v][top.next]
+		top.next++
+
+		lca.first[child] = len(tour)
+		tour = append(tour, child)
+		stack = append(stack, frame{child, 0})
+	}
+
+	return tour
+}
+
+// NewBinaryLiftingLCA prepares the lowest common ancestor queries for the rooted forest
+// where parent[v] is the parent of the vertex v or -1 for a root.
+// Panics if the parents form a cycle.
+// Complexity - O(n log n).
+func NewBinaryLiftingLCA(parent []int) *BinaryLiftingLCA {
+	lca := &BinaryLiftingLCA{forest: newForest(parent)}
+	lca.up = append(lca.up, lca.parent)
+
+	for k := 1; 1<<k < len(parent); k++ {
+		prev := lca.up[k-1]
+		level := make([]int, len(parent))
+
+		for v := range level {
+			if level[v] = prev[v]; level[v] >= 0 {
+				level[v] = prev[level[v]]
+			}
+		}
+
+		lca.up = append(lca.up, level)
+	}
+
+	return lca
+}
+
+// Query returns the lowest common ancestor of the vertices or -1 if they belong to different trees.
+// Complexity - O(log n).
+func (lca *BinaryLiftingLCA) Query(u, v int) int {
+	lca.mustHaveVertex(u)
+	lca.mustHaveVertex(v)
+
+	if lca.root[u] != lca.root[v] {
+		return -1
+	}
+
+	if lca.depth[u] < lca.depth[v] {
+		u, v = v, u
+	}
+
+	u = lca.Ancestor(u, lca.depth[u]-lca.depth[v])
+
+	if u == v {
+		return u
+	}
+
+	for k := len(lca.up) - 1; k >= 0; k-- {
+		if lca.up[k][u] != lca.up[k][v] {
+			u, v = lca.up[k][u], lca.up[k][v]
+		}
+	}
+
+	return lca.parent[u]
+}
+
+// Ancestor returns the k-th ancestor of the vertex, the vertex itself for zero, or -1 if the tree is not so deep.
+// Complexity - O(log k).
+func (lca *BinaryLiftingLCA) Ancestor(v, k int) int {
+	lca.mustHaveVertex(v)
+
+	if k < 0 || k > lca.depth[v] {
+		return -1
+	}
+
+	for ; k > 0; k &= k - 1 {
+		v = lca.up[bits.TrailingZeros(uint(k))][v]
+	}
+
+	return v
+}
+
+func newForest(parent []int) forest {
+	f := forest{
+		parent:   append([]int(nil), parent...),
+		children: make([][]int, len(parent)),
+		depth:    make([]int, len(parent)),
+		root:     make([]int, len(parent)),
+	}
+
+	// order lists the vertices so that a parent always precedes its children
+	order := make([]int, 0, len(parent))
+
+	for v, p := range parent {
+		if p < 0 {
+			order = append(order, v)
+			f.root[v] = v
+		} else {
+			f.mustHaveVertex(p)
+			f.children[p] = append(f.children[p], v)
+		}
+	}
+
+	// the vertices of a cycle are never reached from a root
+	for i := 0; i < len(order); i++ {
+		v := order[i]
+
+		for _, child := range f.children[v] {
+			f.depth[child], f.root[child] = f.depth[v]+1, f.root[v]
+			order = append(order, child)
+		}
+	}
+
+	if len(order) != len(parent) {
+		panic("sparse_table: the parents form a cycle")
+	}
+
+	return f
+}
+
+// Depth returns the number of edges between the vertex and the root of its tree.
+// Complexity - O(1).
+func (f *forest) Depth(v int) int {
+	f.mustHaveVertex(v)
+
+	return f.depth[v]
+}
+
+func (f *forest) mustHaveVertex(v int) {
+	if v < 0 || v >= len(f.parent) {
+		panic(fmt.Sprintf("sparse_table: vertex %d out of range [0, %d)", v, len(f.parent)))
+	}
+}
@@ -0,0 +1,102 @@
+// Copyright 2021. The GTL Authors. All rights reserved.
+// https://github.com/modern-dev/gtl
+// Use of this source code is governed by the MIT
+// license that can be found in the LICENSE file.
+
+package sparse_table
+
+import (
+	"math/rand"
+	"testing"
+)
+
+type lowestCommonAncestor interface {
+	Query(u, v int) int
+	Depth(v int) int
+}
+
+func TestLCA(t *testing.T) {
+	//	    0         7
+	//	   / \        |
+	//	  1   2       8
+	//	 /|   |
+	//	3 4   5
+	//	      |
+	//	      6
+	parent := []int{-1, 0, 0, 1, 1, 2, 5, -1, 7}
+
+	for name, lca := range map[string]lowestCommonAncestor{
+		"EulerTour":     NewEulerTourLCA(parent),
+		"BinaryLifting": NewBinaryLiftingLCA(parent),
+	} {
+		for _, q := range [][3]int{{3, 4, 1}, {3, 6, 0}, {6, 2, 2}, {5, 5, 5}, {8, 7, 7}, {6, 8, -1}} {
+			if got := lca.Query(q[0], q[1]); got != q[2] {
+				t.Errorf("%s: expected LCA(%d, %d) to be %d, got %d", name, q[0], q[1], q[2], got)
+			}
+		}
+
+		if lca.Depth(6) != 3 || lca.Depth(7) != 0 {
+			t.Errorf("%s: expected depths (3, 0), got (%d, %d)", name, lca.Depth(6), lca.Depth(7))
+		}
+	}
+
+	lifting := NewBinaryLiftingLCA(parent)
+
+	for k, expected := range []int{6, 5, 2, 0, -1} {
+		if got := lifting.Ancestor(6, k); got != expected {
+			t.Errorf("Expected ancestor %d of 6 to be %d, got %d", k, expected, got)
+		}
+	}
+}
+
+func TestLCARandomTrees(t *testing.T) {
+	rnd := rand.New(rand.NewSource(17))
+
+	for _, n := range []int{1, 2, 10, 500} {
+		parent := make([]int, n)
+
+		// a shuffled labeling, so that parents do not always precede children
+		labels := rnd.Perm(n)
+		parent[labels[0]] = -1
+
+		for i := 1; i < n; i++ {
+			parent[labels[i]] = labels[rnd.Intn(i)]
+		}
+
+		euler, lifting := NewEulerTourLCA(parent), NewBinaryLiftingLCA(parent)
+
+		for q := 0; q < 500; q++ {
+			u, v := rnd.Intn(n), rnd.Intn(n)
+
+			if expected := naiveLCA(parent, u, v); euler.Query(u, v) != expected || lifting.Query(u, v) != expected {
+				t.Fatalf("Expected LCA(%d, %d) to be %d, got %d and %d", u, v, expected, euler.Query(u, v), lifting.Query(u, v))
+			}
+		}
+	}
+}
+
+func TestLCACycle(t *testing.T) {
+	defer func() {
+		if recover() == nil {
+			t.Errorf("Expected a cycle of parents to panic")
+		}
+	}()
+
+	NewEulerTourLCA([]int{-1, 2, 1})
+}
+
+func naiveLCA(parent []int, u, v int) int {
+	ancestors := make(map[int]bool)
+
+	for ; u >= 0; u = parent[u] {
+		ancestors[u] = true
+	}
+
+	for ; v >= 0; v = parent[v] {
+		if ancestors[v] {
+			return v
+		}
+	}
+
+	return -1
+}
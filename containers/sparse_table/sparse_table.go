@@ -0,0 +1,86 @@
+// Copyright 2021. The GTL Authors. All rights reserved.
+// https://github.com/modern-dev/gtl
+// Use of this source code is governed by the MIT
+// license that can be found in the LICENSE file.
+
+// See the implementation details https://cp-algorithms.com/data_structures/sparse-table.html
+
+package sparse_table
+
+import (
+	"constraints"
+	"fmt"
+	"math/bits"
+)
+
+// SparseTable answers range queries over a static sequence in constant time.
+// The operation must be associative and idempotent, i.e. op(x, x) == x, like min, max, gcd, bitwise and or or,
+// so that a range may be covered by two overlapping blocks of a power of two length.
+type SparseTable[T any] struct {
+	// table[k][i] is the result of op over [i, i + 2^k)
+	table [][]T
+	op    func(lhs, rhs T) T
+}
+
+// NewSparseTable builds a SparseTable over a copy of the values with the idempotent operation.
+// Complexity - O(n log n).
+func NewSparseTable[T any](values []T, op func(lhs, rhs T) T) *SparseTable[T] {
+	st := &SparseTable[T]{op: op}
+	st.table = append(st.table, append([]T(nil), values...))
+
+	for k := 1; 1<<k <= len(values); k++ {
+		prev, half := st.table[k-1], 1<<(k-1)
+		level := make([]T, len(values)-1<<k+1)
+
+		for i := range level {
+			level[i] = op(prev[i], prev[i+half])
+		}
+
+		st.table = append(st.table, level)
+	}
+
+	return st
+}
+
+// NewMinSparseTable builds a SparseTable answering range minimum queries.
+// Complexity - O(n log n).
+func NewMinSparseTable[T constraints.Ordered](values []T) *SparseTable[T] {
+	return NewSparseTable(values, func(lhs, rhs T) T {
+		if rhs < lhs {
+			return rhs
+		}
+
+		return lhs
+	})
+}
+
+// NewMaxSparseTable builds a SparseTable answering range maximum queries.
+// Complexity - O(n log n).
+func NewMaxSparseTable[T constraints.Ordered](values []T) *SparseTable[T] {
+	return NewSparseTable(values, func(lhs, rhs T) T {
+		if rhs > lhs {
+			return rhs
+		}
+
+		return lhs
+	})
+}
+
+// Len returns the number of values in the table.
+// Complexity - O(1).
+func (st *SparseTable[T]) Len() int {
+	return len(st.table[0])
+}
+
+// Query returns the result of the operation over the values in the half-open range [from, to).
+// Panics if the range is empty or out of bounds.
+// Complexity - O(1).
+func (st *SparseTable[T]) Query(from, to int) T {
+	if from < 0 || to > st.Len() || from >= to {
+		panic(fmt.Sprintf("sparse_table: invalid range [%d, %d) of [0, %d)", from, to, st.Len()))
+	}
+
+	k := bits.Len(uint(to-from)) - 1
+
+	return st.op(st.table[k][from], st.table[k][to-1<<k])
+}
@@ -0,0 +1,66 @@
+// Copyright 2021. The GTL Authors. All rights reserved.
+// https://github.com/modern-dev/gtl
+// Use of this source code is governed by the MIT
+// license that can be found in the LICENSE file.
+
+package sparse_table
+
+import (
+	"math/rand"
+	"testing"
+)
+
+func TestSparseTable(t *testing.T) {
+	rnd := rand.New(rand.NewSource(13))
+	values := make([]int, 100)
+
+	for i := range values {
+		values[i] = rnd.Intn(1000)
+	}
+
+	mins, maxs := NewMinSparseTable(values), NewMaxSparseTable(values)
+	ors := NewSparseTable(values, func(lhs, rhs int) int { return lhs | rhs })
+
+	if mins.Len() != len(values) {
+		t.Errorf("Expected length %d, got %d", len(values), mins.Len())
+	}
+
+	for from := 0; from < len(values); from++ {
+		lo, hi, or := values[from], values[from], 0
+
+		for to := from + 1; to <= len(values); to++ {
+			v := values[to-1]
+
+			if v < lo {
+				lo = v
+			}
+
+			if v > hi {
+				hi = v
+			}
+
+			or |= v
+
+			if mins.Query(from, to) != lo || maxs.Query(from, to) != hi || ors.Query(from, to) != or {
+				t.Fatalf("Expected [%d, %d) to give (%d, %d, %d), got (%d, %d, %d)", from, to, lo, hi, or,
+					mins.Query(from, to), maxs.Query(from, to), ors.Query(from, to))
+			}
+		}
+	}
+}
+
+func TestSparseTableInvalidRange(t *testing.T) {
+	st := NewMinSparseTable([]float64{1.5, 0.5})
+
+	for _, r := range [][2]int{{1, 1}, {-1, 1}, {0, 3}, {2, 1}} {
+		func() {
+			defer func() {
+				if recover() == nil {
+					t.Errorf("Expected Query(%d, %d) to panic", r[0], r[1])
+				}
+			}()
+
+			st.Query(r[0], r[1])
+		}()
+	}
+}
@@ -0,0 +1,31 @@
+// Copyright 2021. The GTL Authors. All rights reserved.
+// https://github.com/modern-dev/gtl
+// Use of this source code is governed by the MIT
+// license that can be found in the LICENSE file.
+
+package stack
+
+// MarshalBinary implements the encoding.BinaryMarshaler interface.
+// The elements are encoded in order from bottom to top.
+func (s *Stack[T]) MarshalBinary() ([]byte, error) {
+	return s.lazyInit().MarshalBinary()
+}
+
+// UnmarshalBinary implements the encoding.BinaryUnmarshaler interface.
+// The current contents of the Stack are replaced.
+func (s *Stack[T]) UnmarshalBinary(data []byte) error {
+	return s.lazyInit().UnmarshalBinary(data)
+}
+
+// MarshalJSON implements the json.Marshaler interface.
+// The Stack is encoded as a JSON array ordered from bottom to top.
+func (s *Stack[T]) MarshalJSON() ([]byte, error) {
+	return s.lazyInit().MarshalJSON()
+}
+
+// UnmarshalJSON implements the json.Unmarshaler interface.
+// The current contents of the Stack are replaced.
+func (s *Stack[T]) UnmarshalJSON(data []byte) error {
+	return s.lazyInit().UnmarshalJSON(data)
+}
+
@@ -0,0 +1,156 @@
+// Copyright 2021. The GTL Authors. All rights reserved.
+// https://github.com/modern-dev/gtl
+// Use of this source code is governed by the MIT
+// license that can be found in the LICENSE file.
+
+package stack
+
+import (
+	"constraints"
+
+	"github.com/modern-dev/gtl/utility"
+)
+
+type (
+	// MinMaxStack is a Stack that provides constant time lookup of its smallest and largest elements.
+	// Every stored element is accompanied by the smallest and the largest element below it,
+	// so the extremes are restored in constant time when the top element is popped.
+	MinMaxStack[T any] struct {
+		st      *Stack[minMaxEntry[T]]
+		cmpInst utility.Compare[T]
+	}
+
+	// minMaxEntry is an element of MinMaxStack along with the extremes of the Stack up to it.
+	minMaxEntry[T any] struct {
+		value T
+		min   T
+		max   T
+	}
+)
+
+// NewMinMaxStack creates an empty MinMaxStack.
+func NewMinMaxStack[T constraints.Ordered]() *MinMaxStack[T] {
+	return NewMinMaxStackWithComparator[T](&utility.Less[T]{})
+}
+
+// NewMinMaxStackWithComparator creates an empty MinMaxStack.
+// A utility.Compare type providing a strict weak ordering.
+func NewMinMaxStackWithComparator[T any](comparator utility.Compare[T]) *MinMaxStack[T] {
+	return &MinMaxStack[T]{
+		st:      NewStack[minMaxEntry[T]](),
+		cmpInst: comparator,
+	}
+}
+
+// NewMinMaxStackWithComparatorFunc creates an empty MinMaxStack.
+// A less function providing a strict weak ordering, see utility.CompareFunc.
+func NewMinMaxStackWithComparatorFunc[T any](less func(lhs, rhs T) bool) *MinMaxStack[T] {
+	return NewMinMaxStackWithComparator[T](utility.CompareFunc[T](less))
+}
+
+// NewMinMaxStackWithOrdering creates an empty MinMaxStack.
+// A utility.Ordering three-way comparator providing a strict weak ordering.
+func NewMinMaxStackWithOrdering[T any](ordering utility.Ordering[T]) *MinMaxStack[T] {
+	return NewMinMaxStackWithComparator[T](utility.ToCompare(ordering))
+}
+
+// Size returns the number of elements in the MinMaxStack.
+// Complexity - constant e.g. O(1).
+func (s *MinMaxStack[T]) Size() int {
+	return s.st.Size()
+}
+
+// Empty checks if the MinMaxStack has no elements.
+// Complexity - constant e.g. O(1).
+func (s *MinMaxStack[T]) Empty() bool {
+	return s.st.Empty()
+}
+
+// Push pushes the given element value to the top of the MinMaxStack.
+// Complexity - constant e.g. O(1).
+func (s *MinMaxStack[T]) Push(item T) {
+	entry := minMaxEntry[T]{item, item, item}
+
+	if top, ok := s.st.TryTop(); ok {
+		if s.cmpInst.Cmp(top.min, item) {
+			entry.min = top.min
+		}
+
+		if s.cmpInst.Cmp(item, top.max) {
+			entry.max = top.max
+		}
+	}
+
+	s.st.Push(entry)
+}
+
+// Top returns the top element in the MinMaxStack.
+// Calling Top on an empty MinMaxStack panics with containers.ErrEmpty, see TryTop.
+// Complexity - constant e.g. O(1).
+func (s *MinMaxStack[T]) Top() T {
+	return s.st.Top().value
+}
+
+// Pop removes the top element from the MinMaxStack.
+// Calling Pop on an empty MinMaxStack panics with containers.ErrEmpty, see TryPop.
+// Complexity - constant e.g. O(1).
+func (s *MinMaxStack[T]) Pop() T {
+	return s.st.Pop().value
+}
+
+// Peek returns the element n positions below the top of the MinMaxStack, Peek(0) is the same as Top.
+// If n is not within the range of the MinMaxStack, a panic is thrown.
+// Complexity - O(min(n, size-n)), where size is the number of elements in the MinMaxStack.
+func (s *MinMaxStack[T]) Peek(n int) T {
+	return s.st.Peek(n).value
+}
+
+// Min returns the smallest element in the MinMaxStack according to the comparator.
+// Calling Min on an empty MinMaxStack panics with containers.ErrEmpty, see TryMin.
+// Complexity - constant e.g. O(1).
+func (s *MinMaxStack[T]) Min() T {
+	return s.st.Top().min
+}
+
+// Max returns the largest element in the MinMaxStack according to the comparator.
+// Calling Max on an empty MinMaxStack panics with containers.ErrEmpty, see TryMax.
+// Complexity - constant e.g. O(1).
+func (s *MinMaxStack[T]) Max() T {
+	return s.st.Top().max
+}
+
+// TryTop returns the top element in the MinMaxStack.
+// Returns the zero value of T and false if the MinMaxStack is empty.
+// Complexity - constant e.g. O(1).
+func (s *MinMaxStack[T]) TryTop() (T, bool) {
+	entry, ok := s.st.TryTop()
+
+	return entry.value, ok
+}
+
+// TryPop removes the top element from the MinMaxStack.
+// Returns the zero value of T and false if the MinMaxStack is empty.
+// Complexity - constant e.g. O(1).
+func (s *MinMaxStack[T]) TryPop() (T, bool) {
+	entry, ok := s.st.TryPop()
+
+	return entry.value, ok
+}
+
+// TryMin returns the smallest element in the MinMaxStack according to the comparator.
+// Returns the zero value of T and false if the MinMaxStack is empty.
+// Complexity - constant e.g. O(1).
+func (s *MinMaxStack[T]) TryMin() (T, bool) {
+	entry, ok := s.st.TryTop()
+
+	return entry.min, ok
+}
+
+// TryMax returns the largest element in the MinMaxStack according to the comparator.
+// Returns the zero value of T and false if the MinMaxStack is empty.
+// Complexity - constant e.g. O(1).
+func (s *MinMaxStack[T]) TryMax() (T, bool) {
+	entry, ok := s.st.TryTop()
+
+	return entry.max, ok
+}
@@ -0,0 +1,79 @@
+// Copyright 2021. The GTL Authors. All rights reserved.
+// https://github.com/modern-dev/gtl
+// Use of this source code is governed by the MIT
+// license that can be found in the LICENSE file.
+
+package stack
+
+import (
+	"testing"
+
+	"github.com/modern-dev/gtl/utility"
+)
+
+func TestMinMaxStack(t *testing.T) {
+	s := NewMinMaxStack[int]()
+
+	if _, ok := s.TryMin(); ok {
+		t.Errorf("Expected TryMin() on an empty stack to fail")
+	}
+
+	cases := []struct {
+		push     int
+		min, max int
+	}{
+		{5, 5, 5},
+		{3, 3, 5},
+		{7, 3, 7},
+		{3, 3, 7},
+		{1, 1, 7},
+	}
+
+	for _, c := range cases {
+		s.Push(c.push)
+
+		if s.Min() != c.min || s.Max() != c.max {
+			t.Errorf("After Push(%d) expected extremes [%d, %d], got [%d, %d]", c.push, c.min, c.max, s.Min(), s.Max())
+		}
+	}
+
+	for i := len(cases) - 1; i > 0; i-- {
+		if got := s.Pop(); got != cases[i].push {
+			t.Errorf("Expected Pop() to return %d, got %d", cases[i].push, got)
+		}
+
+		if s.Min() != cases[i-1].min || s.Max() != cases[i-1].max {
+			t.Errorf("After Pop() expected extremes [%d, %d], got [%d, %d]", cases[i-1].min, cases[i-1].max, s.Min(), s.Max())
+		}
+	}
+}
+
+func TestMinMaxStackWithComparator(t *testing.T) {
+	s := NewMinMaxStackWithComparator[string](utility.ToCompare(utility.CaseInsensitive()))
+
+	for _, item := range []string{"b", "C", "a"} {
+		s.Push(item)
+	}
+
+	if s.Min() != "a" || s.Max() != "C" || s.Peek(1) != "C" {
+		t.Errorf("Expected extremes [a, C], got [%s, %s]", s.Min(), s.Max())
+	}
+}
+
+func TestMinMaxStackWithOrdering(t *testing.T) {
+	s := NewMinMaxStackWithOrdering[string](utility.CaseInsensitive())
+	byLength := NewMinMaxStackWithComparatorFunc(func(lhs, rhs string) bool { return len(lhs) < len(rhs) })
+
+	for _, v := range []string{"delta", "Alpha", "bb", "Charlie"} {
+		s.Push(v)
+		byLength.Push(v)
+	}
+
+	if s.Min() != "Alpha" || s.Max() != "delta" {
+		t.Errorf("Expected to get [%v, %v], got [%v, %v]", "Alpha", "delta", s.Min(), s.Max())
+	}
+
+	if byLength.Min() != "bb" || byLength.Max() != "Charlie" {
+		t.Errorf("Expected to get [%v, %v], got [%v, %v]", "bb", "Charlie", byLength.Min(), byLength.Max())
+	}
+}
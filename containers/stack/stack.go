@@ -6,51 +6,156 @@
 package stack
 
 import (
+	"github.com/modern-dev/gtl/containers"
 	. "github.com/modern-dev/gtl/containers/deque"
 )
 
+// OverflowPolicy defines what happens when an element is pushed into a bounded Stack that reached its capacity.
+type OverflowPolicy uint8
+
+const (
+	// RejectNewest makes the push fail, leaving the Stack intact.
+	RejectNewest OverflowPolicy = iota
+	// DropOldest removes the bottom element of the Stack to make room for the pushed one.
+	DropOldest
+)
+
 // Stack is a container adapter that gives the programmer the functionality of a stack
 // - specifically, a LIFO (last-in, first-out) data structure.
+// The zero value is an empty unbounded Stack ready to use.
 type Stack[T any] struct {
-	dq *Deque[T]
+	dq       *Deque[T]
+	capacity int
+	policy   OverflowPolicy
 }
 
-// NewStack TODO
+// NewStack creates an empty unbounded Stack.
 func NewStack[T any]() *Stack[T] {
-	dq := NewDeque[T]()
+	return &Stack[T]{dq: NewDeque[T]()}
+}
 
-	return &Stack[T]{dq}
+// NewBoundedStack creates an empty Stack holding at most capacity elements.
+// The policy defines what happens when an element is pushed into the full Stack.
+// A capacity of zero or less means that the Stack is unbounded.
+func NewBoundedStack[T any](capacity int, policy OverflowPolicy) *Stack[T] {
+	if capacity < 0 {
+		capacity = 0
+	}
+
+	return &Stack[T]{
+		dq:       NewDeque[T](),
+		capacity: capacity,
+		policy:   policy,
+	}
+}
+
+// Capacity returns the maximum number of elements in the Stack, zero if the Stack is unbounded.
+// Complexity - constant e.g. O(1).
+func (s *Stack[T]) Capacity() int {
+	return s.capacity
 }
 
 // Size returns the number of elements in the underlying container.
 // Complexity - constant e.g. O(1).
 // Returns the number of elements in the container.
 func (s *Stack[T]) Size() int {
-	return s.dq.Size()
+	return s.lazyInit().Size()
 }
 
 // Empty checks if the underlying container has no elements.
 // Complexity - constant e.g. O(1).
 // Returns true if the underlying container is empty, false otherwise.
 func (s *Stack[T]) Empty() bool {
-	return s.dq.Empty()
+	return s.lazyInit().Empty()
 }
 
 // Push pushes the given element value to the top of the Stack.
+// Pushing into a full bounded Stack with the RejectNewest policy panics with containers.ErrFull, see TryPush.
 // Complexity - constant e.g. O(1).
 func (s *Stack[T]) Push(item T) {
-	s.dq.PushBack(item)
+	if !s.TryPush(item) {
+		panic(containers.ErrFull)
+	}
+}
+
+// TryPush pushes the given element value to the top of the Stack.
+// Returns false if the Stack is bounded, full and its policy is RejectNewest.
+// With the DropOldest policy the bottom element is removed from the full Stack and the push always succeeds.
+// Complexity - constant e.g. O(1).
+func (s *Stack[T]) TryPush(item T) bool {
+	if s.capacity > 0 && s.Size() >= s.capacity {
+		if s.policy == RejectNewest {
+			return false
+		}
+
+		s.lazyInit().PopFront()
+	}
+
+	s.lazyInit().PushBack(item)
+
+	return true
 }
 
 // Top returns reference to the top element in the Stack.
 // This is the most recently pushed element. This element will be removed on a call to Pop.
+// Calling Top on an empty Stack panics with containers.ErrEmpty, see TryTop.
 // Complexity - constant e.g. O(1).
 func (s *Stack[T]) Top() T {
-	return s.dq.Back()
+	return s.lazyInit().Back()
 }
 
 // Pop removes the top element from the Stack.
 // Returns the object at the top of this Stack.
+// Calling Pop on an empty Stack panics with containers.ErrEmpty, see TryPop.
 func (s *Stack[T]) Pop() T {
-	return s.dq.PopBack()
+	return s.lazyInit().PopBack()
+}
+
+// Each calls fn for every element from bottom to top, the same order they are encoded in, until fn returns false.
+// The Stack must not be modified during the iteration.
+// Complexity - O(n).
+func (s *Stack[T]) Each(fn func(T) bool) {
+	s.lazyInit().Each(fn)
+}
+
+// TryTop returns the top element in the Stack.
+// Returns the zero value of T and false if the Stack is empty.
+// Complexity - constant e.g. O(1).
+func (s *Stack[T]) TryTop() (T, bool) {
+	return s.lazyInit().TryBack()
+}
+
+// TryPop removes the top element from the Stack.
+// Returns the object at the top of this Stack, or the zero value of T and false if the Stack is empty.
+func (s *Stack[T]) TryPop() (T, bool) {
+	return s.lazyInit().TryPopBack()
+}
+
+// Peek returns the element n positions below the top of the Stack, Peek(0) is the same as Top.
+// If n is not within the range of the Stack, a panic is thrown.
+// Complexity - O(min(n, size-n)), where size is the number of elements in the Stack.
+func (s *Stack[T]) Peek(n int) T {
+	return s.lazyInit().At(s.Size() - 1 - n)
+}
+
+// TryPeek returns the element n positions below the top of the Stack.
+// Returns the zero value of T and false if n is not within the range of the Stack.
+// Complexity - O(min(n, size-n)), where size is the number of elements in the Stack.
+func (s *Stack[T]) TryPeek(n int) (T, bool) {
+	if n < 0 || n >= s.Size() {
+		var emptyEl T
+
+		return emptyEl, false
+	}
+
+	return s.Peek(n), true
+}
+
+// lazyInit lazily initializes the underlying container of a zero Stack value.
+func (s *Stack[T]) lazyInit() *Deque[T] {
+	if s.dq == nil {
+		s.dq = NewDeque[T]()
+	}
+
+	return s.dq
 }
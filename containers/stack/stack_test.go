@@ -5,7 +5,13 @@
 
 package stack
 
-import "testing"
+import (
+	"reflect"
+	"testing"
+
+	"github.com/modern-dev/gtl/containers"
+	"github.com/modern-dev/gtl/funcs"
+)
 
 func TestNewStack(t *testing.T) {
 	var (
@@ -24,3 +30,141 @@ func checkStackSize[T any](s *Stack[T], expected int, t *testing.T) {
 		t.Errorf("stack should have size %d but got %d", expected, s.Size())
 	}
 }
+
+func TestZeroStack(t *testing.T) {
+	var s Stack[int]
+
+	if s.Size() != 0 || !s.Empty() {
+		t.Errorf("Expected zero Stack to be empty, got size %d", s.Size())
+	}
+
+	if _, ok := s.TryPop(); ok {
+		t.Errorf("Expected TryPop() on a zero Stack to fail")
+	}
+
+	s.Push(1)
+	s.Push(2)
+
+	if s.Top() != 2 || s.Peek(1) != 1 || s.Pop() != 2 || s.Size() != 1 {
+		t.Errorf("Expected zero Stack to work as an unbounded one")
+	}
+}
+
+func TestStackEncoding(t *testing.T) {
+	s := NewStack[int]()
+
+	for i := 0; i < 10; i++ {
+		s.Push(i)
+	}
+
+	bin, err := s.MarshalBinary()
+	if err != nil {
+		t.Fatalf("MarshalBinary() failed: %v", err)
+	}
+
+	js, err := s.MarshalJSON()
+	if err != nil {
+		t.Fatalf("MarshalJSON() failed: %v", err)
+	}
+
+	var fromBin, fromJSON Stack[int]
+
+	if err := fromBin.UnmarshalBinary(bin); err != nil {
+		t.Fatalf("UnmarshalBinary() failed: %v", err)
+	}
+
+	if err := fromJSON.UnmarshalJSON(js); err != nil {
+		t.Fatalf("UnmarshalJSON() failed: %v", err)
+	}
+
+	for _, restored := range []*Stack[int]{&fromBin, &fromJSON} {
+		checkStackSize(restored, 10, t)
+
+		for i := 9; i >= 0; i-- {
+			if el := restored.Pop(); el != i {
+				t.Errorf("Expected to get %d, got %d", i, el)
+			}
+		}
+	}
+}
+
+func TestStackTryAccessors(t *testing.T) {
+	s := NewStack[string]()
+
+	if _, err := containers.ErrIfEmpty(s.TryPop()); err != containers.ErrEmpty {
+		t.Errorf("Expected TryPop() on an empty stack to fail with %v, got %v", containers.ErrEmpty, err)
+	}
+
+	s.Push("bottom")
+	s.Push("top")
+
+	if el, ok := s.TryTop(); !ok || el != "top" {
+		t.Errorf("Expected to get (%s, %t), got (%s, %t)", "top", true, el, ok)
+	}
+
+	if el, ok := s.TryPop(); !ok || el != "top" {
+		t.Errorf("Expected to get (%s, %t), got (%s, %t)", "top", true, el, ok)
+	}
+
+	checkStackSize(s, 1, t)
+}
+
+func TestBoundedStack(t *testing.T) {
+	rejecting := NewBoundedStack[int](3, RejectNewest)
+	dropping := NewBoundedStack[int](3, DropOldest)
+
+	for i := 0; i < 5; i++ {
+		if ok := rejecting.TryPush(i); ok != (i < 3) {
+			t.Errorf("Expected TryPush(%d) to return %t, got %t", i, i < 3, ok)
+		}
+
+		dropping.Push(i)
+	}
+
+	checkStackSize(rejecting, 3, t)
+	checkStackSize(dropping, 3, t)
+
+	for n, want := range []int{2, 1, 0} {
+		if got := rejecting.Peek(n); got != want {
+			t.Errorf("Expected Peek(%d) to return %d, got %d", n, want, got)
+		}
+	}
+
+	for n, want := range []int{4, 3, 2} {
+		if got := dropping.Peek(n); got != want {
+			t.Errorf("Expected Peek(%d) to return %d, got %d", n, want, got)
+		}
+	}
+
+	if _, ok := dropping.TryPeek(3); ok {
+		t.Errorf("Expected TryPeek(%d) to fail", 3)
+	}
+
+	defer func() {
+		if r := recover(); r != containers.ErrFull {
+			t.Errorf("Expected Push() into a full stack to panic with %v, got %v", containers.ErrFull, r)
+		}
+	}()
+
+	rejecting.Push(42)
+}
+
+func TestStackEach(t *testing.T) {
+	var empty Stack[int]
+
+	if got := funcs.Collect(empty.Each); got != nil {
+		t.Errorf("Expected no elements, got %v", got)
+	}
+
+	s := NewStack[int]()
+
+	for i := 1; i <= 4; i++ {
+		s.Push(i)
+	}
+
+	s.Pop()
+
+	if got, want := funcs.Collect(s.Each), []int{1, 2, 3}; !reflect.DeepEqual(got, want) {
+		t.Errorf("Expected to get %v, got %v", want, got)
+	}
+}
@@ -0,0 +1,55 @@
+// Copyright 2021. The GTL Authors. All rights reserved.
+// https://github.com/modern-dev/gtl
+// Use of this source code is governed by the MIT
+// license that can be found in the LICENSE file.
+
+package treap
+
+import (
+	"encoding/json"
+
+	"github.com/modern-dev/gtl/internal/serial"
+)
+
+// binaryVersion is the version of the format produced by MarshalBinary.
+const binaryVersion byte = 1
+
+// MarshalBinary implements the encoding.BinaryMarshaler interface.
+// Only the elements are encoded, the shape of the tree is rebuilt when decoding.
+func (t *ImplicitTreap[T]) MarshalBinary() ([]byte, error) {
+	return serial.Marshal(binaryVersion, t.Values())
+}
+
+// UnmarshalBinary implements the encoding.BinaryUnmarshaler interface.
+// The current contents of the ImplicitTreap are replaced.
+func (t *ImplicitTreap[T]) UnmarshalBinary(data []byte) error {
+	values, err := serial.Unmarshal[[]T](data, binaryVersion)
+
+	if err != nil {
+		return err
+	}
+
+	t.root = t.build(values)
+
+	return nil
+}
+
+// MarshalJSON implements the json.Marshaler interface.
+// The ImplicitTreap is encoded as a JSON array.
+func (t *ImplicitTreap[T]) MarshalJSON() ([]byte, error) {
+	return json.Marshal(t.Values())
+}
+
+// UnmarshalJSON implements the json.Unmarshaler interface.
+// The current contents of the ImplicitTreap are replaced.
+func (t *ImplicitTreap[T]) UnmarshalJSON(data []byte) error {
+	var values []T
+
+	if err := json.Unmarshal(data, &values); err != nil {
+		return err
+	}
+
+	t.root = t.build(values)
+
+	return nil
+}
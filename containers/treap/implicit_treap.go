@@ -0,0 +1,355 @@
+// Copyright 2021. The GTL Authors. All rights reserved.
+// https://github.com/modern-dev/gtl
+// Use of this source code is governed by the MIT
+// license that can be found in the LICENSE file.
+
+// Package treap implements treaps, randomized binary search trees kept balanced by heap-ordered random priorities.
+package treap
+
+import (
+	"fmt"
+	"sync/atomic"
+
+	"github.com/modern-dev/gtl/internal/hashing"
+)
+
+// priorities is the state of the splitmix64 generator of node priorities.
+// It is shared by all treaps, so that nodes of separately built treaps never get the same priorities
+// and the treaps stay balanced when merged.
+var priorities uint64
+
+type (
+	// ImplicitTreap is a sequence, like vector.Vector, that supports insertion, removal, splitting,
+	// concatenation and reversal at arbitrary positions in expected logarithmic time.
+	// The elements are kept in a treap keyed implicitly by their positions: the position of a node
+	// is the number of nodes to its left, so the keys never have to be stored or updated.
+	// The zero value is an empty sequence ready to use.
+	ImplicitTreap[T any] struct {
+		root *node[T]
+	}
+
+	node[T any] struct {
+		value       T
+		priority    uint64
+		size        int
+		reversed    bool
+		left, right *node[T]
+	}
+)
+
+// NewImplicitTreap creates an empty ImplicitTreap.
+func NewImplicitTreap[T any]() *ImplicitTreap[T] {
+	return &ImplicitTreap[T]{}
+}
+
+// NewImplicitTreapFrom creates an ImplicitTreap holding a copy of the given values in the same order.
+// Complexity - O(n).
+func NewImplicitTreapFrom[T any](values []T) *ImplicitTreap[T] {
+	t := NewImplicitTreap[T]()
+	t.root = t.build(values)
+
+	return t
+}
+
+// Size returns the number of elements in the sequence.
+// Complexity - O(1).
+func (t *ImplicitTreap[T]) Size() int {
+	return size(t.root)
+}
+
+// Empty checks if the sequence has no elements.
+// Complexity - O(1).
+func (t *ImplicitTreap[T]) Empty() bool {
+	return t.root == nil
+}
+
+// At returns the element at the position pos.
+// If pos is not within the range of the sequence, a panic is thrown.
+// Complexity - O(log n) expected.
+func (t *ImplicitTreap[T]) At(pos int) T {
+	return t.find(pos).value
+}
+
+// Set replaces the element at the position pos.
+// If pos is not within the range of the sequence, a panic is thrown.
+// Complexity - O(log n) expected.
+func (t *ImplicitTreap[T]) Set(pos int, value T) {
+	t.find(pos).value = value
+}
+
+// Insert inserts the values before the position pos, so that the first of them ends up at pos.
+// If pos is not within [0, Size()], a panic is thrown.
+// Complexity - O(k + log n) expected, where k is the number of the inserted values.
+func (t *ImplicitTreap[T]) Insert(pos int, values ...T) {
+	t.mustBeInRange(pos, t.Size()+1)
+
+	l, r := split(t.root, pos)
+	t.root = merge(merge(l, t.build(values)), r)
+}
+
+// PushBack appends the value to the end of the sequence.
+// Complexity - O(log n) expected.
+func (t *ImplicitTreap[T]) PushBack(value T) {
+	t.root = merge(t.root, t.newNode(value))
+}
+
+// PushFront prepends the value to the beginning of the sequence.
+// Complexity - O(log n) expected.
+func (t *ImplicitTreap[T]) PushFront(value T) {
+	t.root = merge(t.newNode(value), t.root)
+}
+
+// Erase removes and returns the element at the position pos.
+// If pos is not within the range of the sequence, a panic is thrown.
+// Complexity - O(log n) expected.
+func (t *ImplicitTreap[T]) Erase(pos int) T {
+	t.mustBeInRange(pos, t.Size())
+
+	l, r := split(t.root, pos)
+	mid, r := split(r, 1)
+	t.root = merge(l, r)
+
+	return mid.value
+}
+
+// EraseRange removes the elements in the half-open range [from, to).
+// If the range is not within the sequence, a panic is thrown.
+// Complexity - O(log n) expected.
+func (t *ImplicitTreap[T]) EraseRange(from, to int) {
+	l, _, r := t.cut(from, to)
+	t.root = merge(l, r)
+}
+
+// Split removes the elements starting at the position pos and returns them as a new ImplicitTreap,
+// so that the sequence keeps the elements before pos.
+// If pos is not within [0, Size()], a panic is thrown.
+// Complexity - O(log n) expected.
+func (t *ImplicitTreap[T]) Split(pos int) *ImplicitTreap[T] {
+	t.mustBeInRange(pos, t.Size()+1)
+
+	l, r := split(t.root, pos)
+	t.root = l
+
+	return &ImplicitTreap[T]{root: r}
+}
+
+// Merge moves all elements of other to the end of the sequence, other is left empty.
+// Complexity - O(log n) expected.
+func (t *ImplicitTreap[T]) Merge(other *ImplicitTreap[T]) {
+	if t == other {
+		return
+	}
+
+	t.root = merge(t.root, other.root)
+	other.root = nil
+}
+
+// Reverse reverses the order of the elements in the half-open range [from, to).
+// If the range is not within the sequence, a panic is thrown.
+// Complexity - O(log n) expected, the reversal is applied lazily.
+func (t *ImplicitTreap[T]) Reverse(from, to int) {
+	l, mid, r := t.cut(from, to)
+
+	if mid != nil {
+		mid.reversed = !mid.reversed
+	}
+
+	t.root = merge(merge(l, mid), r)
+}
+
+// Each calls fn for every element in order until fn returns false.
+// The sequence must not be modified by fn.
+// Complexity - O(n).
+func (t *ImplicitTreap[T]) Each(fn func(T) bool) {
+	var stack []*node[T]
+
+	for n := t.root; n != nil || len(stack) > 0; n = n.right {
+		for ; n != nil; n = n.left {
+			n.push()
+			stack = append(stack, n)
+		}
+
+		n = stack[len(stack)-1]
+		stack = stack[:len(stack)-1]
+
+		if !fn(n.value) {
+			return
+		}
+	}
+}
+
+// Values returns the elements of the sequence as a slice.
+// Complexity - O(n).
+func (t *ImplicitTreap[T]) Values() []T {
+	res := make([]T, 0, t.Size())
+
+	t.Each(func(value T) bool {
+		res = append(res, value)
+
+		return true
+	})
+
+	return res
+}
+
+// Clear removes all elements from the sequence.
+// Complexity - O(1).
+func (t *ImplicitTreap[T]) Clear() {
+	t.root = nil
+}
+
+func (t *ImplicitTreap[T]) find(pos int) *node[T] {
+	t.mustBeInRange(pos, t.Size())
+
+	n := t.root
+
+	for {
+		n.push()
+
+		switch ls := size(n.left); {
+		case pos < ls:
+			n = n.left
+		case pos > ls:
+			pos -= ls + 1
+			n = n.right
+		default:
+			return n
+		}
+	}
+}
+
+// cut splits the treap into the elements before from, the elements of [from, to) and the elements after.
+func (t *ImplicitTreap[T]) cut(from, to int) (*node[T], *node[T], *node[T]) {
+	if from < 0 || to > t.Size() || from > to {
+		panic(fmt.Sprintf("treap: invalid range [%d, %d) of sequence of size %d", from, to, t.Size()))
+	}
+
+	l, r := split(t.root, from)
+	mid, r := split(r, to-from)
+
+	return l, mid, r
+}
+
+// build creates a treap of the values in linear time.
+// The nodes are added along the right spine of the tree, which is kept on the stack.
+func (t *ImplicitTreap[T]) build(values []T) *node[T] {
+	var stack []*node[T]
+
+	for _, value := range values {
+		n := t.newNode(value)
+
+		var last *node[T]
+
+		for len(stack) > 0 && stack[len(stack)-1].priority < n.priority {
+			last = stack[len(stack)-1]
+			stack = stack[:len(stack)-1]
+			last.update()
+		}
+
+		n.left = last
+
+		if len(stack) > 0 {
+			stack[len(stack)-1].right = n
+		}
+
+		stack = append(stack, n)
+	}
+
+	for i := len(stack) - 1; i >= 0; i-- {
+		stack[i].update()
+	}
+
+	if len(stack) == 0 {
+		return nil
+	}
+
+	return stack[0]
+}
+
+func (t *ImplicitTreap[T]) newNode(value T) *node[T] {
+	return &node[T]{value: value, priority: hashing.Mix(atomic.AddUint64(&priorities, hashing.Golden)), size: 1}
+}
+
+func (t *ImplicitTreap[T]) mustBeInRange(pos, size int) {
+	if pos < 0 || pos >= size {
+		panic(fmt.Sprintf("treap: index %d out of range [0, %d)", pos, size))
+	}
+}
+
+// split splits the treap into the first k elements and the rest.
+func split[T any](n *node[T], k int) (*node[T], *node[T]) {
+	if n == nil {
+		return nil, nil
+	}
+
+	n.push()
+
+	if size(n.left) < k {
+		l, r := split(n.right, k-size(n.left)-1)
+		n.right = l
+		n.update()
+
+		return n, r
+	}
+
+	l, r := split(n.left, k)
+	n.left = r
+	n.update()
+
+	return l, n
+}
+
+// merge concatenates two treaps.
+func merge[T any](l, r *node[T]) *node[T] {
+	if l == nil {
+		return r
+	}
+
+	if r == nil {
+		return l
+	}
+
+	if l.priority > r.priority {
+		l.push()
+		l.right = merge(l.right, r)
+		l.update()
+
+		return l
+	}
+
+	r.push()
+	r.left = merge(l, r.left)
+	r.update()
+
+	return r
+}
+
+// push applies the pending reversal of the subtree to its root and hands it to the children.
+func (n *node[T]) push() {
+	if !n.reversed {
+		return
+	}
+
+	n.left, n.right = n.right, n.left
+	n.reversed = false
+
+	if n.left != nil {
+		n.left.reversed = !n.left.reversed
+	}
+
+	if n.right != nil {
+		n.right.reversed = !n.right.reversed
+	}
+}
+
+func (n *node[T]) update() {
+	n.size = size(n.left) + size(n.right) + 1
+}
+
+func size[T any](n *node[T]) int {
+	if n == nil {
+		return 0
+	}
+
+	return n.size
+}
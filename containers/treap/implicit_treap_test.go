@@ -0,0 +1,285 @@
+// Copyright 2021. The GTL Authors. All rights reserved.
+// https://github.com/modern-dev/gtl
+// Use of this source code is governed by the MIT
+// license that can be found in the LICENSE file.
+
+package treap
+
+import (
+	"math/rand"
+	"reflect"
+	"testing"
+)
+
+// checkTreap checks the sizes and the heap order of the priorities of every node.
+func checkTreap[T any](t *testing.T, tr *ImplicitTreap[T]) {
+	t.Helper()
+
+	var walk func(n *node[T]) int
+
+	walk = func(n *node[T]) int {
+		if n == nil {
+			return 0
+		}
+
+		for _, child := range []*node[T]{n.left, n.right} {
+			if child != nil && child.priority > n.priority {
+				t.Fatalf("Expected the priorities to be heap ordered")
+			}
+		}
+
+		if s := walk(n.left) + walk(n.right) + 1; s != n.size {
+			t.Fatalf("Expected to get size %d, got %d", s, n.size)
+		}
+
+		return n.size
+	}
+
+	walk(tr.root)
+}
+
+func checkValues(t *testing.T, tr *ImplicitTreap[int], want []int) {
+	t.Helper()
+
+	if got := tr.Values(); !reflect.DeepEqual(got, want) && !(len(got) == 0 && len(want) == 0) {
+		t.Fatalf("Expected to get %v, got %v", want, got)
+	}
+
+	if tr.Size() != len(want) || tr.Empty() != (len(want) == 0) {
+		t.Fatalf("Expected to get (%d, %t), got (%d, %t)", len(want), len(want) == 0, tr.Size(), tr.Empty())
+	}
+
+	checkTreap(t, tr)
+}
+
+func TestImplicitTreap(t *testing.T) {
+	var tr ImplicitTreap[int]
+
+	checkValues(t, &tr, nil)
+
+	tr.PushBack(3)
+	tr.PushBack(4)
+	tr.PushFront(1)
+	tr.Insert(1, 2)
+	tr.Insert(4, 5, 6, 7)
+	tr.Insert(0, 0)
+
+	checkValues(t, &tr, []int{0, 1, 2, 3, 4, 5, 6, 7})
+
+	if tr.At(5) != 5 {
+		t.Errorf("Expected to get 5, got %d", tr.At(5))
+	}
+
+	tr.Set(5, 50)
+
+	if got := tr.Erase(5); got != 50 {
+		t.Errorf("Expected to get 50, got %d", got)
+	}
+
+	tr.EraseRange(0, 2)
+
+	checkValues(t, &tr, []int{2, 3, 4, 6, 7})
+
+	tr.Reverse(1, 4)
+
+	checkValues(t, &tr, []int{2, 6, 4, 3, 7})
+
+	tr.Reverse(0, 5)
+
+	checkValues(t, &tr, []int{7, 3, 4, 6, 2})
+
+	tr.Clear()
+
+	checkValues(t, &tr, nil)
+}
+
+func TestImplicitTreapSplitMerge(t *testing.T) {
+	tr := NewImplicitTreapFrom([]int{0, 1, 2, 3, 4, 5, 6, 7, 8, 9})
+	tail := tr.Split(6)
+
+	checkValues(t, tr, []int{0, 1, 2, 3, 4, 5})
+	checkValues(t, tail, []int{6, 7, 8, 9})
+
+	tail.Reverse(0, 4)
+	tail.Merge(tr)
+
+	checkValues(t, tail, []int{9, 8, 7, 6, 0, 1, 2, 3, 4, 5})
+	checkValues(t, tr, nil)
+
+	// the split off sequences keep working on their own
+	tr.PushBack(10)
+	tail.Merge(tail)
+
+	checkValues(t, tr, []int{10})
+	checkValues(t, tail, []int{9, 8, 7, 6, 0, 1, 2, 3, 4, 5})
+
+	empty := tail.Split(10)
+
+	checkValues(t, empty, nil)
+
+	whole := tail.Split(0)
+
+	checkValues(t, tail, nil)
+	checkValues(t, whole, []int{9, 8, 7, 6, 0, 1, 2, 3, 4, 5})
+}
+
+func TestImplicitTreapRandomized(t *testing.T) {
+	rnd := rand.New(rand.NewSource(42))
+	tr := NewImplicitTreap[int]()
+
+	var want []int
+
+	for i := 0; i < 5000; i++ {
+		switch op := rnd.Intn(6); {
+		case op <= 1 || len(want) == 0:
+			pos := rnd.Intn(len(want) + 1)
+			tr.Insert(pos, i, -i)
+			want = append(want[:pos], append([]int{i, -i}, want[pos:]...)...)
+		case op == 2:
+			pos := rnd.Intn(len(want))
+
+			if got := tr.Erase(pos); got != want[pos] {
+				t.Fatalf("Expected to get %d, got %d", want[pos], got)
+			}
+
+			want = append(want[:pos], want[pos+1:]...)
+		case op == 3:
+			from := rnd.Intn(len(want) + 1)
+			to := from + rnd.Intn(len(want)-from+1)
+			tr.Reverse(from, to)
+
+			for l, r := from, to-1; l < r; l, r = l+1, r-1 {
+				want[l], want[r] = want[r], want[l]
+			}
+		case op == 4:
+			pos := rnd.Intn(len(want) + 1)
+			tail := tr.Split(pos)
+			tail.Merge(tr)
+			tr = tail
+			want = append(append([]int{}, want[pos:]...), want[:pos]...)
+		default:
+			pos := rnd.Intn(len(want))
+
+			if got := tr.At(pos); got != want[pos] {
+				t.Fatalf("Expected to get %d, got %d", want[pos], got)
+			}
+		}
+	}
+
+	checkValues(t, tr, want)
+}
+
+func TestImplicitTreapMergeBalance(t *testing.T) {
+	tr := NewImplicitTreap[int]()
+
+	for i := 0; i < 20000; i++ {
+		single := NewImplicitTreap[int]()
+		single.PushBack(i)
+		tr.Merge(single)
+	}
+
+	var depth func(n *node[int]) int
+
+	depth = func(n *node[int]) int {
+		if n == nil {
+			return 0
+		}
+
+		l, r := depth(n.left), depth(n.right)
+
+		if l > r {
+			return l + 1
+		}
+
+		return r + 1
+	}
+
+	// the expected depth is about 3 * ln(n), that is 30
+	if d := depth(tr.root); d > 60 {
+		t.Errorf("Expected the depth to be logarithmic, got %d", d)
+	}
+
+	if tr.At(12345) != 12345 {
+		t.Errorf("Expected to get 12345, got %d", tr.At(12345))
+	}
+}
+
+func TestImplicitTreapEach(t *testing.T) {
+	tr := NewImplicitTreapFrom([]int{1, 2, 3, 4, 5})
+	tr.Reverse(0, 5)
+
+	var got []int
+
+	tr.Each(func(value int) bool {
+		got = append(got, value)
+
+		return value != 3
+	})
+
+	if want := []int{5, 4, 3}; !reflect.DeepEqual(got, want) {
+		t.Errorf("Expected to get %v, got %v", want, got)
+	}
+}
+
+func TestImplicitTreapOutOfRange(t *testing.T) {
+	tr := NewImplicitTreapFrom([]int{1, 2, 3})
+
+	for name, fn := range map[string]func(){
+		"At":         func() { tr.At(3) },
+		"Set":        func() { tr.Set(-1, 0) },
+		"Erase":      func() { tr.Erase(3) },
+		"Insert":     func() { tr.Insert(4, 0) },
+		"Split":      func() { tr.Split(-1) },
+		"Reverse":    func() { tr.Reverse(2, 1) },
+		"EraseRange": func() { tr.EraseRange(0, 4) },
+	} {
+		func() {
+			defer func() {
+				if recover() == nil {
+					t.Errorf("Expected %s to panic", name)
+				}
+			}()
+
+			fn()
+		}()
+	}
+
+	checkValues(t, tr, []int{1, 2, 3})
+}
+
+func TestImplicitTreapEncoding(t *testing.T) {
+	tr := NewImplicitTreapFrom([]string{"a", "b", "c", "d"})
+	tr.Reverse(1, 3)
+
+	bin, err := tr.MarshalBinary()
+	if err != nil {
+		t.Fatalf("MarshalBinary() failed: %v", err)
+	}
+
+	js, err := tr.MarshalJSON()
+	if err != nil {
+		t.Fatalf("MarshalJSON() failed: %v", err)
+	}
+
+	if string(js) != `["a","c","b","d"]` {
+		t.Errorf("Expected to get %s, got %s", `["a","c","b","d"]`, js)
+	}
+
+	fromBin, fromJSON := NewImplicitTreap[string](), NewImplicitTreapFrom([]string{"x"})
+
+	if err := fromBin.UnmarshalBinary(bin); err != nil {
+		t.Fatalf("UnmarshalBinary() failed: %v", err)
+	}
+
+	if err := fromJSON.UnmarshalJSON(js); err != nil {
+		t.Fatalf("UnmarshalJSON() failed: %v", err)
+	}
+
+	for _, got := range []*ImplicitTreap[string]{fromBin, fromJSON} {
+		if want := tr.Values(); !reflect.DeepEqual(got.Values(), want) {
+			t.Errorf("Expected to get %v, got %v", want, got.Values())
+		}
+
+		checkTreap(t, got)
+	}
+}
@@ -75,3 +75,64 @@ func earliestAcq(logs [][]int, N int) int {
 
 	return -1
 }
+
+func TestDisjointSetEncoding(t *testing.T) {
+	ds := NewDisjointSet(6)
+	ds.Union(0, 2)
+	ds.Union(4, 2)
+	ds.Union(3, 1)
+
+	bin, err := ds.MarshalBinary()
+	if err != nil {
+		t.Fatalf("MarshalBinary() failed: %v", err)
+	}
+
+	js, err := ds.MarshalJSON()
+	if err != nil {
+		t.Fatalf("MarshalJSON() failed: %v", err)
+	}
+
+	fromBin, fromJSON := &DisjointSet{}, &DisjointSet{}
+
+	if err := fromBin.UnmarshalBinary(bin); err != nil {
+		t.Fatalf("UnmarshalBinary() failed: %v", err)
+	}
+
+	if err := fromJSON.UnmarshalJSON(js); err != nil {
+		t.Fatalf("UnmarshalJSON() failed: %v", err)
+	}
+
+	for _, restored := range []*DisjointSet{fromBin, fromJSON} {
+		if restored.Len() != ds.Len() {
+			t.Errorf("Expected to get %d, got %d", ds.Len(), restored.Len())
+		}
+
+		for x := 0; x < ds.Len(); x++ {
+			for y := 0; y < ds.Len(); y++ {
+				if expected, given := ds.Find(x) == ds.Find(y), restored.Find(x) == restored.Find(y); expected != given {
+					t.Errorf("Expected connectivity of %d and %d to be %t, got %t", x, y, expected, given)
+				}
+			}
+		}
+	}
+
+	for _, data := range []string{
+		`{"parent":[0,5],"rank":[0,0]}`,             // parent out of range
+		`{"parent":[0],"rank":[0,0]}`,               // mismatched lengths
+		`{"parent":[1,0],"rank":[0,0]}`,             // cycle of two
+		`{"parent":[0,2,3,4,2],"rank":[0,0,0,0,0]}`, // cycle after a chain
+		`{"parent":[0,0],"rank":[1,-1]}`,            // negative rank
+	} {
+		if err := fromJSON.UnmarshalJSON([]byte(data)); err != ErrCorrupted {
+			t.Errorf("Expected to get %v for %s, got %v", ErrCorrupted, data, err)
+		}
+	}
+
+	if err := fromJSON.UnmarshalJSON([]byte(`{"parent":[0,0,1,2],"rank":[2,1,0,0]}`)); err != nil {
+		t.Fatalf("UnmarshalJSON() failed: %v", err)
+	}
+
+	if fromJSON.Find(3) != 0 {
+		t.Errorf("Expected to get 0, got %d", fromJSON.Find(3))
+	}
+}
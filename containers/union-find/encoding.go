@@ -0,0 +1,122 @@
+// Copyright 2021. The GTL Authors. All rights reserved.
+// https://github.com/modern-dev/gtl
+// Use of this source code is governed by the MIT
+// license that can be found in the LICENSE file.
+
+package union_find
+
+import (
+	"encoding/json"
+	"errors"
+
+	"github.com/modern-dev/gtl/internal/serial"
+)
+
+// binaryVersion is the version of the format produced by MarshalBinary.
+const binaryVersion byte = 1
+
+// ErrCorrupted is returned when the decoded data does not describe a valid disjoint set.
+var ErrCorrupted = errors.New("union_find: corrupted data")
+
+// disjointSetState is the serialized representation of DisjointSet.
+type disjointSetState struct {
+	Parent []int `json:"parent"`
+	Rank   []int `json:"rank"`
+}
+
+// MarshalBinary implements the encoding.BinaryMarshaler interface.
+func (this *DisjointSet) MarshalBinary() ([]byte, error) {
+	return serial.Marshal(binaryVersion, this.state())
+}
+
+// UnmarshalBinary implements the encoding.BinaryUnmarshaler interface.
+func (this *DisjointSet) UnmarshalBinary(data []byte) error {
+	state, err := serial.Unmarshal[disjointSetState](data, binaryVersion)
+
+	if err != nil {
+		return err
+	}
+
+	return this.assign(state)
+}
+
+// MarshalJSON implements the json.Marshaler interface.
+// The DisjointSet is encoded as a JSON object holding the parent and rank arrays.
+func (this *DisjointSet) MarshalJSON() ([]byte, error) {
+	return json.Marshal(this.state())
+}
+
+// UnmarshalJSON implements the json.Unmarshaler interface.
+func (this *DisjointSet) UnmarshalJSON(data []byte) error {
+	var state disjointSetState
+
+	if err := json.Unmarshal(data, &state); err != nil {
+		return err
+	}
+
+	return this.assign(state)
+}
+
+func (this *DisjointSet) state() disjointSetState {
+	return disjointSetState{
+		Parent: append([]int{}, this.parent...),
+		Rank:   append([]int{}, this.rank...),
+	}
+}
+
+func (this *DisjointSet) assign(state disjointSetState) error {
+	n := len(state.Parent)
+
+	if len(state.Rank) != n {
+		return ErrCorrupted
+	}
+
+	for i, p := range state.Parent {
+		if p < 0 || p >= n || state.Rank[i] < 0 {
+			return ErrCorrupted
+		}
+	}
+
+	if hasCycle(state.Parent) {
+		return ErrCorrupted
+	}
+
+	this.size = n
+	this.parent = append([]int{}, state.Parent...)
+	this.rank = append([]int{}, state.Rank...)
+
+	return nil
+}
+
+// hasCycle checks if following the parents from some element never reaches a root.
+// Every chain is walked once, marking its elements first as in progress and then as done.
+func hasCycle(parent []int) bool {
+	const (
+		unvisited = iota
+		inProgress
+		done
+	)
+
+	marks := make([]byte, len(parent))
+
+	for i := range parent {
+		x := i
+
+		for marks[x] == unvisited && parent[x] != x {
+			marks[x] = inProgress
+			x = parent[x]
+		}
+
+		if marks[x] == inProgress {
+			return true
+		}
+
+		for x = i; marks[x] == inProgress; x = parent[x] {
+			marks[x] = done
+		}
+
+		marks[x] = done
+	}
+
+	return false
+}
@@ -0,0 +1,84 @@
+// Copyright 2021. The GTL Authors. All rights reserved.
+// https://github.com/modern-dev/gtl
+// Use of this source code is governed by the MIT
+// license that can be found in the LICENSE file.
+
+package unordered_map
+
+// UnorderedMap is unordered map based on standard map.
+// It can contain comparable keys only, see hash_map.HashMap for other keys.
+type UnorderedMap[K comparable, V any] struct {
+	table map[K]V
+}
+
+// NewUnorderedMap creates an empty UnorderedMap.
+func NewUnorderedMap[K comparable, V any]() *UnorderedMap[K, V] {
+	return NewUnorderedMapWithCapacity[K, V](0)
+}
+
+// NewUnorderedMapWithCapacity creates an empty UnorderedMap with space for at least capacity entries.
+func NewUnorderedMapWithCapacity[K comparable, V any](capacity int) *UnorderedMap[K, V] {
+	return &UnorderedMap[K, V]{
+		make(map[K]V, capacity),
+	}
+}
+
+// Size returns the number of entries in the container.
+// Complexity - O(1).
+func (m *UnorderedMap[K, V]) Size() int {
+	return len(m.table)
+}
+
+// Empty checks if there are entries in the container.
+// Complexity - O(1).
+func (m *UnorderedMap[K, V]) Empty() bool {
+	return m.Size() == 0
+}
+
+// Insert stores the value for the key, replacing the previous one.
+// Complexity - O(1).
+func (m *UnorderedMap[K, V]) Insert(key K, value V) {
+	m.table[key] = value
+}
+
+// Get returns the value stored for the key.
+// Returns the zero value of V and false if there is no such key.
+// Complexity - O(1).
+func (m *UnorderedMap[K, V]) Get(key K) (V, bool) {
+	value, ok := m.table[key]
+
+	return value, ok
+}
+
+// Contains checks if the container holds the key.
+// Complexity - O(1).
+func (m *UnorderedMap[K, V]) Contains(key K) bool {
+	_, ok := m.table[key]
+
+	return ok
+}
+
+// Erase deletes the entry for the key if the container holds it, does nothing otherwise.
+// Complexity - O(1).
+func (m *UnorderedMap[K, V]) Erase(key K) {
+	delete(m.table, key)
+}
+
+// Each calls fn for every entry until fn returns false.
+// The order of the iteration is not specified. The container must not be modified during the iteration.
+// Complexity - O(n), where n is the number of entries.
+func (m *UnorderedMap[K, V]) Each(fn func(key K, value V) bool) {
+	for key, value := range m.table {
+		if !fn(key, value) {
+			return
+		}
+	}
+}
+
+// Clear removes all entries from the container.
+// Complexity - O(n), where n is the number of entries.
+func (m *UnorderedMap[K, V]) Clear() {
+	for key := range m.table {
+		delete(m.table, key)
+	}
+}
@@ -0,0 +1,52 @@
+// Copyright 2021. The GTL Authors. All rights reserved.
+// https://github.com/modern-dev/gtl
+// Use of this source code is governed by the MIT
+// license that can be found in the LICENSE file.
+
+package unordered_map
+
+import "testing"
+
+func TestUnorderedMap(t *testing.T) {
+	m := NewUnorderedMap[string, int]()
+
+	if !m.Empty() {
+		t.Errorf("Expected new map to be empty")
+	}
+
+	m.Insert("one", 1)
+	m.Insert("two", 2)
+	m.Insert("one", 11)
+
+	if value, ok := m.Get("one"); !ok || value != 11 {
+		t.Errorf("Expected to get (%d, %t), got (%d, %t)", 11, true, value, ok)
+	}
+
+	if value, ok := m.Get("three"); ok || value != 0 {
+		t.Errorf("Expected to get (%d, %t), got (%d, %t)", 0, false, value, ok)
+	}
+
+	m.Erase("two")
+
+	if m.Contains("two") || m.Size() != 1 {
+		t.Errorf("Expected erased key to be absent")
+	}
+
+	sum := 0
+
+	m.Each(func(_ string, value int) bool {
+		sum += value
+
+		return true
+	})
+
+	if sum != 11 {
+		t.Errorf("Expected values to sum to %d, got %d", 11, sum)
+	}
+
+	m.Clear()
+
+	if !m.Empty() {
+		t.Errorf("Expected map to be empty after Clear()")
+	}
+}
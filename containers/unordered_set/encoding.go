@@ -0,0 +1,72 @@
+// Copyright 2021. The GTL Authors. All rights reserved.
+// https://github.com/modern-dev/gtl
+// Use of this source code is governed by the MIT
+// license that can be found in the LICENSE file.
+
+package unordered_set
+
+import (
+	"encoding/json"
+
+	"github.com/modern-dev/gtl/internal/serial"
+)
+
+// binaryVersion is the version of the format produced by MarshalBinary.
+const binaryVersion byte = 1
+
+// MarshalBinary implements the encoding.BinaryMarshaler interface.
+func (s *UnorderedSet[T]) MarshalBinary() ([]byte, error) {
+	return serial.Marshal(binaryVersion, s.values())
+}
+
+// UnmarshalBinary implements the encoding.BinaryUnmarshaler interface.
+// The current contents of the UnorderedSet are replaced.
+func (s *UnorderedSet[T]) UnmarshalBinary(data []byte) error {
+	values, err := serial.Unmarshal[[]T](data, binaryVersion)
+
+	if err != nil {
+		return err
+	}
+
+	s.assign(values)
+
+	return nil
+}
+
+// MarshalJSON implements the json.Marshaler interface.
+// The UnorderedSet is encoded as a JSON array in unspecified order.
+func (s *UnorderedSet[T]) MarshalJSON() ([]byte, error) {
+	return json.Marshal(s.values())
+}
+
+// UnmarshalJSON implements the json.Unmarshaler interface.
+// The current contents of the UnorderedSet are replaced.
+func (s *UnorderedSet[T]) UnmarshalJSON(data []byte) error {
+	var values []T
+
+	if err := json.Unmarshal(data, &values); err != nil {
+		return err
+	}
+
+	s.assign(values)
+
+	return nil
+}
+
+func (s *UnorderedSet[T]) values() []T {
+	values := make([]T, 0, len(s.table))
+
+	for item := range s.table {
+		values = append(values, item)
+	}
+
+	return values
+}
+
+func (s *UnorderedSet[T]) assign(values []T) {
+	s.table = make(map[T]bool, len(values))
+
+	for _, item := range values {
+		s.Insert(item)
+	}
+}
@@ -53,3 +53,14 @@ func (s *UnorderedSet[T]) Erase(item T) {
 		delete(s.table, item)
 	}
 }
+
+// Each calls fn for every element until fn returns false.
+// The order of the iteration is not specified. The set must not be modified during the iteration.
+// Complexity - O(n).
+func (s *UnorderedSet[T]) Each(fn func(item T) bool) {
+	for item := range s.table {
+		if !fn(item) {
+			return
+		}
+	}
+}
@@ -7,6 +7,8 @@ package unordered_set
 
 import (
 	"testing"
+
+	"github.com/modern-dev/gtl/funcs"
 )
 
 const addsCount = 3000
@@ -96,3 +98,66 @@ func checkEmpty[T comparable](s *UnorderedSet[T], isEmpty bool, t *testing.T) {
 		t.Errorf("Expected IsEmpty to be %v, got %v", isEmpty, s.Empty())
 	}
 }
+
+func TestUnorderedSetEncoding(t *testing.T) {
+	s := NewUnorderedSet[string]()
+
+	for _, item := range []string{"foo", "bar", "baz"} {
+		s.Insert(item)
+	}
+
+	bin, err := s.MarshalBinary()
+	if err != nil {
+		t.Fatalf("MarshalBinary() failed: %v", err)
+	}
+
+	js, err := s.MarshalJSON()
+	if err != nil {
+		t.Fatalf("MarshalJSON() failed: %v", err)
+	}
+
+	fromBin, fromJSON := NewUnorderedSet[string](), NewUnorderedSet[string]()
+	fromBin.Insert("qux")
+
+	if err := fromBin.UnmarshalBinary(bin); err != nil {
+		t.Fatalf("UnmarshalBinary() failed: %v", err)
+	}
+
+	if err := fromJSON.UnmarshalJSON(js); err != nil {
+		t.Fatalf("UnmarshalJSON() failed: %v", err)
+	}
+
+	for _, restored := range []*UnorderedSet[string]{fromBin, fromJSON} {
+		checkSize(restored, 3, t)
+
+		for _, item := range []string{"foo", "bar", "baz"} {
+			if !restored.Contains(item) {
+				t.Errorf("Expected unordered_set to contain %s", item)
+			}
+		}
+	}
+}
+
+func TestUnorderedSetEach(t *testing.T) {
+	s := NewUnorderedSet[int]()
+
+	for i := 0; i < 10; i++ {
+		s.Insert(i)
+	}
+
+	if got := funcs.SumBySeq(s.Each, func(n int) int { return n }); got != 45 {
+		t.Errorf("Expected to get %d, got %d", 45, got)
+	}
+
+	visited := 0
+
+	s.Each(func(int) bool {
+		visited++
+
+		return false
+	})
+
+	if visited != 1 {
+		t.Errorf("Expected Each() to stop after %d elements, got %d", 1, visited)
+	}
+}
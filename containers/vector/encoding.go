@@ -0,0 +1,54 @@
+// Copyright 2021. The GTL Authors. All rights reserved.
+// https://github.com/modern-dev/gtl
+// Use of this source code is governed by the MIT
+// license that can be found in the LICENSE file.
+
+package vector
+
+import (
+	"encoding/json"
+
+	"github.com/modern-dev/gtl/internal/serial"
+)
+
+// binaryVersion is the version of the format produced by MarshalBinary.
+const binaryVersion byte = 1
+
+// MarshalBinary implements the encoding.BinaryMarshaler interface.
+func (v *Vector[T]) MarshalBinary() ([]byte, error) {
+	return serial.Marshal(binaryVersion, v.ar)
+}
+
+// UnmarshalBinary implements the encoding.BinaryUnmarshaler interface.
+// The current contents of the Vector are replaced.
+func (v *Vector[T]) UnmarshalBinary(data []byte) error {
+	ar, err := serial.Unmarshal[[]T](data, binaryVersion)
+
+	if err != nil {
+		return err
+	}
+
+	v.ar = append([]T{}, ar...)
+
+	return nil
+}
+
+// MarshalJSON implements the json.Marshaler interface.
+// The Vector is encoded as a JSON array.
+func (v *Vector[T]) MarshalJSON() ([]byte, error) {
+	return json.Marshal(append([]T{}, v.ar...))
+}
+
+// UnmarshalJSON implements the json.Unmarshaler interface.
+// The current contents of the Vector are replaced.
+func (v *Vector[T]) UnmarshalJSON(data []byte) error {
+	var ar []T
+
+	if err := json.Unmarshal(data, &ar); err != nil {
+		return err
+	}
+
+	v.ar = append([]T{}, ar...)
+
+	return nil
+}
@@ -5,6 +5,10 @@
 
 package vector
 
+import (
+	"github.com/modern-dev/gtl/containers"
+)
+
 type Vector[T any] struct {
 	ar []T
 }
@@ -46,20 +50,38 @@ func (v *Vector[T]) Capacity() int {
 	return cap(v.ar)
 }
 
+// Each calls fn for every element in order until fn returns false.
+// Each has the signature of funcs.Seq, so v.Each may be passed wherever a Seq is expected.
+// Complexity - O(n).
+func (v *Vector[T]) Each(fn func(T) bool) {
+	for _, item := range v.ar {
+		if !fn(item) {
+			return
+		}
+	}
+}
+
 // Front returns a reference to the first element in the container.
-// Calling Front on an empty container is undefined.
+// Calling Front on an empty container panics with containers.ErrEmpty, see TryFront.
 // Complexity - O(1).
 func (v *Vector[T]) Front() T {
+	v.mustNotBeEmpty()
+
 	return v.ar[0]
 }
 
 // Back returns a reference to the last element in the container.
-// Calling Back on an empty container causes undefined behavior.
+// Calling Back on an empty container panics with containers.ErrEmpty, see TryBack.
 // Complexity - O(1).
 func (v *Vector[T]) Back() T {
+	v.mustNotBeEmpty()
+
 	return v.ar[v.Size()-1]
 }
 
+// PopBack removes and returns the last element of the container.
+// Calling PopBack on an empty container panics with containers.ErrEmpty, see TryPopBack.
+// Complexity - O(1).
 func (v *Vector[T]) PopBack() T {
 	res := v.Back()
 
@@ -68,6 +90,51 @@ func (v *Vector[T]) PopBack() T {
 	return res
 }
 
+// TryFront returns the first element in the container.
+// Returns the zero value of T and false if the container is empty.
+// Complexity - O(1).
+func (v *Vector[T]) TryFront() (T, bool) {
+	if v.Empty() {
+		var emptyEl T
+
+		return emptyEl, false
+	}
+
+	return v.Front(), true
+}
+
+// TryBack returns the last element in the container.
+// Returns the zero value of T and false if the container is empty.
+// Complexity - O(1).
+func (v *Vector[T]) TryBack() (T, bool) {
+	if v.Empty() {
+		var emptyEl T
+
+		return emptyEl, false
+	}
+
+	return v.Back(), true
+}
+
+// TryPopBack removes and returns the last element of the container.
+// Returns the zero value of T and false if the container is empty.
+// Complexity - O(1).
+func (v *Vector[T]) TryPopBack() (T, bool) {
+	if v.Empty() {
+		var emptyEl T
+
+		return emptyEl, false
+	}
+
+	return v.PopBack(), true
+}
+
+func (v *Vector[T]) mustNotBeEmpty() {
+	if v.Empty() {
+		panic(containers.ErrEmpty)
+	}
+}
+
 // Pop(pos int) T
 // Erase(pos int)
 // Insert(pos int, el T)
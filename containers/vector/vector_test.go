@@ -0,0 +1,117 @@
+// Copyright 2021. The GTL Authors. All rights reserved.
+// https://github.com/modern-dev/gtl
+// Use of this source code is governed by the MIT
+// license that can be found in the LICENSE file.
+
+package vector
+
+import (
+	"reflect"
+	"testing"
+
+	"github.com/modern-dev/gtl/containers"
+	"github.com/modern-dev/gtl/funcs"
+)
+
+func TestVectorEncoding(t *testing.T) {
+	v := NewVector[float64]()
+
+	for i := 0; i < 10; i++ {
+		v.PushBack(float64(i) / 2)
+	}
+
+	bin, err := v.MarshalBinary()
+	if err != nil {
+		t.Fatalf("MarshalBinary() failed: %v", err)
+	}
+
+	js, err := v.MarshalJSON()
+	if err != nil {
+		t.Fatalf("MarshalJSON() failed: %v", err)
+	}
+
+	fromBin, fromJSON := NewVector[float64](), NewVector[float64]()
+
+	if err := fromBin.UnmarshalBinary(bin); err != nil {
+		t.Fatalf("UnmarshalBinary() failed: %v", err)
+	}
+
+	if err := fromJSON.UnmarshalJSON(js); err != nil {
+		t.Fatalf("UnmarshalJSON() failed: %v", err)
+	}
+
+	for _, restored := range []*Vector[float64]{fromBin, fromJSON} {
+		if restored.Size() != v.Size() {
+			t.Fatalf("Expected vector size %d, got %d", v.Size(), restored.Size())
+		}
+
+		for i := 0; i < v.Size(); i++ {
+			if restored.At(i) != v.At(i) {
+				t.Errorf("Expected to get %v at %d, got %v", v.At(i), i, restored.At(i))
+			}
+		}
+	}
+}
+
+func TestVectorTryAccessors(t *testing.T) {
+	v := NewVector[int]()
+
+	if _, ok := v.TryFront(); ok {
+		t.Errorf("Expected TryFront() on an empty vector to fail")
+	}
+
+	if _, ok := v.TryPopBack(); ok {
+		t.Errorf("Expected TryPopBack() on an empty vector to fail")
+	}
+
+	v.PushBack(1)
+	v.PushBack(2)
+
+	if el, ok := v.TryBack(); !ok || el != 2 {
+		t.Errorf("Expected to get (%d, %t), got (%d, %t)", 2, true, el, ok)
+	}
+
+	if el, ok := v.TryPopBack(); !ok || el != 2 {
+		t.Errorf("Expected to get (%d, %t), got (%d, %t)", 2, true, el, ok)
+	}
+
+	if el, ok := v.TryFront(); !ok || el != 1 {
+		t.Errorf("Expected to get (%d, %t), got (%d, %t)", 1, true, el, ok)
+	}
+
+	v.PopBack()
+
+	defer func() {
+		if r := recover(); r != containers.ErrEmpty {
+			t.Errorf("Expected Back() on an empty vector to panic with %v, got %v", containers.ErrEmpty, r)
+		}
+	}()
+
+	v.Back()
+}
+
+func TestVectorEach(t *testing.T) {
+	v := NewVector[int]()
+
+	for i := 1; i <= 5; i++ {
+		v.PushBack(i)
+	}
+
+	squares := funcs.Collect(funcs.MapSeq(v.Each, func(n int) int { return n * n }))
+
+	if want := []int{1, 4, 9, 16, 25}; !reflect.DeepEqual(squares, want) {
+		t.Errorf("Expected to get %v, got %v", want, squares)
+	}
+
+	visited := 0
+
+	v.Each(func(n int) bool {
+		visited++
+
+		return n < 2
+	})
+
+	if visited != 2 {
+		t.Errorf("Expected Each() to stop after %d elements, got %d", 2, visited)
+	}
+}
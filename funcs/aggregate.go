@@ -0,0 +1,173 @@
+// Copyright 2021. The GTL Authors. All rights reserved.
+// https://github.com/modern-dev/gtl
+// Use of this source code is governed by the MIT
+// license that can be found in the LICENSE file.
+
+package funcs
+
+import "constraints"
+
+// Number is a constraint for the types that support addition.
+type Number interface {
+	constraints.Integer | constraints.Float | constraints.Complex
+}
+
+// ReduceSeq folds the elements of seq into an accumulator starting with init.
+func ReduceSeq[T any, A any](seq Seq[T], init A, fn func(acc A, el T) A) A {
+	acc := init
+
+	seq(func(el T) bool {
+		acc = fn(acc, el)
+
+		return true
+	})
+
+	return acc
+}
+
+// GroupBySeq groups the elements of seq by their keys, preserving the order within each group.
+func GroupBySeq[T any, K comparable](seq Seq[T], key func(T) K) map[K][]T {
+	res := make(map[K][]T)
+
+	seq(func(el T) bool {
+		k := key(el)
+		res[k] = append(res[k], el)
+
+		return true
+	})
+
+	return res
+}
+
+// PartitionSeq splits the elements of seq into the ones that satisfy pred and the ones that do not.
+func PartitionSeq[T any](seq Seq[T], pred func(T) bool) ([]T, []T) {
+	var yes, no []T
+
+	seq(func(el T) bool {
+		if pred(el) {
+			yes = append(yes, el)
+		} else {
+			no = append(no, el)
+		}
+
+		return true
+	})
+
+	return yes, no
+}
+
+// DistinctSeq returns the elements of seq without repetitions, keeping the first occurrences in their order.
+func DistinctSeq[T comparable](seq Seq[T]) []T {
+	var res []T
+
+	seen := make(map[T]struct{})
+
+	seq(func(el T) bool {
+		if _, ok := seen[el]; !ok {
+			seen[el] = struct{}{}
+			res = append(res, el)
+		}
+
+		return true
+	})
+
+	return res
+}
+
+// KeyBySeq returns the map of the elements of seq by their keys. The last element wins for equal keys.
+func KeyBySeq[T any, K comparable](seq Seq[T], key func(T) K) map[K]T {
+	return AssociateSeq(seq, func(el T) (K, T) {
+		return key(el), el
+	})
+}
+
+// AssociateSeq returns the map of the entries produced by fn for the elements of seq.
+// The last entry wins for equal keys.
+func AssociateSeq[T any, K comparable, V any](seq Seq[T], fn func(T) (K, V)) map[K]V {
+	res := make(map[K]V)
+
+	seq(func(el T) bool {
+		k, v := fn(el)
+		res[k] = v
+
+		return true
+	})
+
+	return res
+}
+
+// AnySeq checks if at least one element of seq satisfies pred. It stops at the first such element.
+func AnySeq[T any](seq Seq[T], pred func(T) bool) bool {
+	found := false
+
+	seq(func(el T) bool {
+		found = pred(el)
+
+		return !found
+	})
+
+	return found
+}
+
+// AllSeq checks if all elements of seq satisfy pred. It stops at the first element that does not.
+func AllSeq[T any](seq Seq[T], pred func(T) bool) bool {
+	return !AnySeq(seq, func(el T) bool {
+		return !pred(el)
+	})
+}
+
+// NoneSeq checks if no element of seq satisfies pred. It stops at the first element that does.
+func NoneSeq[T any](seq Seq[T], pred func(T) bool) bool {
+	return !AnySeq(seq, pred)
+}
+
+// CountSeq returns the number of elements of seq that satisfy pred.
+func CountSeq[T any](seq Seq[T], pred func(T) bool) int {
+	return ReduceSeq(seq, 0, func(acc int, el T) int {
+		if pred(el) {
+			acc++
+		}
+
+		return acc
+	})
+}
+
+// SumBySeq returns the sum of fn applied to every element of seq.
+func SumBySeq[T any, N Number](seq Seq[T], fn func(T) N) N {
+	return ReduceSeq(seq, N(0), func(acc N, el T) N {
+		return acc + fn(el)
+	})
+}
+
+// MinBySeq returns the first element of seq with the least key. Returns false if seq is empty.
+func MinBySeq[T any, K constraints.Ordered](seq Seq[T], key func(T) K) (T, bool) {
+	return extremeBy(seq, key, func(lhs, rhs K) bool {
+		return lhs < rhs
+	})
+}
+
+// MaxBySeq returns the first element of seq with the greatest key. Returns false if seq is empty.
+func MaxBySeq[T any, K constraints.Ordered](seq Seq[T], key func(T) K) (T, bool) {
+	return extremeBy(seq, key, func(lhs, rhs K) bool {
+		return lhs > rhs
+	})
+}
+
+// extremeBy returns the first element of seq whose key is not beaten by any other key.
+func extremeBy[T any, K any](seq Seq[T], key func(T) K, beats func(lhs, rhs K) bool) (T, bool) {
+	var (
+		best    T
+		bestKey K
+		found   bool
+	)
+
+	seq(func(el T) bool {
+		if k := key(el); !found || beats(k, bestKey) {
+			best, bestKey, found = el, k, true
+		}
+
+		return true
+	})
+
+	return best, found
+}
@@ -0,0 +1,124 @@
+// Copyright 2021. The GTL Authors. All rights reserved.
+// https://github.com/modern-dev/gtl
+// Use of this source code is governed by the MIT
+// license that can be found in the LICENSE file.
+
+package funcs
+
+import (
+	"testing"
+
+	"github.com/modern-dev/gtl/containers/rbtree"
+	"github.com/modern-dev/gtl/containers/unordered_map"
+	"github.com/modern-dev/gtl/utility"
+)
+
+type employee struct {
+	name   string
+	team   string
+	salary float64
+}
+
+var staff = []employee{
+	{"ann", "dev", 120},
+	{"bob", "ops", 90},
+	{"cid", "dev", 100},
+	{"dee", "qa", 90},
+}
+
+func TestGroupingHelpers(t *testing.T) {
+	byTeam := GroupBy(staff, func(e employee) string { return e.team })
+
+	if len(byTeam) != 3 || len(byTeam["dev"]) != 2 || byTeam["dev"][1].name != "cid" {
+		t.Errorf("Expected 3 teams with [ann cid] in dev, got %v", byTeam)
+	}
+
+	byName := KeyBy(staff, func(e employee) string { return e.name })
+
+	if byName["bob"].team != "ops" || len(byName) != len(staff) {
+		t.Errorf("Expected to find bob in ops, got %v", byName)
+	}
+
+	salaries := Associate(staff, func(e employee) (string, float64) { return e.name, e.salary })
+
+	if salaries["dee"] != 90 || len(salaries) != len(staff) {
+		t.Errorf("Expected dee to earn %d, got %v", 90, salaries)
+	}
+}
+
+func TestPredicates(t *testing.T) {
+	isDev := func(e employee) bool { return e.team == "dev" }
+	isRich := func(e employee) bool { return e.salary > 200 }
+
+	if !Any(staff, isDev) || All(staff, isDev) || None(staff, isDev) {
+		t.Errorf("Expected some but not all employees to be in dev")
+	}
+
+	if Any(staff, isRich) || !None(staff, isRich) || !All([]employee{}, isRich) {
+		t.Errorf("Expected no employee to be rich")
+	}
+
+	if n := Count(staff, isDev); n != 2 {
+		t.Errorf("Expected %d employees in dev, got %d", 2, n)
+	}
+
+	calls := 0
+
+	AnySeq(Values(staff), func(e employee) bool {
+		calls++
+
+		return isDev(e)
+	})
+
+	if calls != 1 {
+		t.Errorf("Expected AnySeq() to stop at the first match, got %d calls", calls)
+	}
+}
+
+func TestNumericHelpers(t *testing.T) {
+	salary := func(e employee) float64 { return e.salary }
+
+	if total := SumBy(staff, salary); total != 400 {
+		t.Errorf("Expected total %f, got %f", 400.0, total)
+	}
+
+	if e, ok := MinBy(staff, salary); !ok || e.name != "bob" {
+		t.Errorf("Expected to get (%s, %t), got (%s, %t)", "bob", true, e.name, ok)
+	}
+
+	if e, ok := MaxBy(staff, salary); !ok || e.name != "ann" {
+		t.Errorf("Expected to get (%s, %t), got (%s, %t)", "ann", true, e.name, ok)
+	}
+
+	if _, ok := MaxBy([]employee{}, salary); ok {
+		t.Errorf("Expected MaxBy() of an empty slice to fail")
+	}
+}
+
+func TestContainerIterators(t *testing.T) {
+	tree := rbtree.NewRBTree[int](false)
+
+	for _, n := range []int{5, 3, 8, 1} {
+		tree.Insert(n)
+	}
+
+	assertSlice(Collect[int](tree.Each), []int{1, 3, 5, 8}, t)
+
+	if sum := SumBySeq[int](tree.Each, func(n int) int { return n }); sum != 17 {
+		t.Errorf("Expected sum %d, got %d", 17, sum)
+	}
+
+	m := unordered_map.NewUnorderedMap[string, int]()
+	m.Insert("a", 1)
+	m.Insert("b", 2)
+
+	entries := Pairs[string, int](m.Each)
+
+	if total := SumBySeq(entries, func(p utility.Pair[string, int]) int { return p.Second }); total != 3 {
+		t.Errorf("Expected sum %d, got %d", 3, total)
+	}
+
+	if p, ok := MaxBySeq(entries, func(p utility.Pair[string, int]) int { return p.Second }); !ok || p.First != "b" {
+		t.Errorf("Expected to get (%s, %t), got (%s, %t)", "b", true, p.First, ok)
+	}
+}
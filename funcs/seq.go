@@ -0,0 +1,68 @@
+// Copyright 2021. The GTL Authors. All rights reserved.
+// https://github.com/modern-dev/gtl
+// Use of this source code is governed by the MIT
+// license that can be found in the LICENSE file.
+
+package funcs
+
+import "github.com/modern-dev/gtl/utility"
+
+type (
+	// Seq is an iterator over a sequence of elements.
+	// It calls yield for every element until yield returns false.
+	// The Each methods of the containers have this signature, so tree.Each may be passed wherever Seq is expected.
+	Seq[T any] func(yield func(T) bool)
+
+	// Seq2 is an iterator over a sequence of pairs of elements, like the entries of a map.
+	Seq2[K any, V any] func(yield func(K, V) bool)
+)
+
+// Values returns the Seq over the elements of the slice.
+func Values[T any](s []T) Seq[T] {
+	return func(yield func(T) bool) {
+		for _, el := range s {
+			if !yield(el) {
+				return
+			}
+		}
+	}
+}
+
+// PullValues returns the pull iterator over the elements of the slice.
+// Every call returns the next element, or false once the slice is exhausted.
+func PullValues[T any](s []T) func() (T, bool) {
+	return func() (T, bool) {
+		if len(s) == 0 {
+			var emptyEl T
+
+			return emptyEl, false
+		}
+
+		el := s[0]
+		s = s[1:]
+
+		return el, true
+	}
+}
+
+// Pairs returns the Seq over the pairs of the Seq2.
+func Pairs[K any, V any](seq Seq2[K, V]) Seq[utility.Pair[K, V]] {
+	return func(yield func(utility.Pair[K, V]) bool) {
+		seq(func(key K, value V) bool {
+			return yield(utility.Pair[K, V]{First: key, Second: value})
+		})
+	}
+}
+
+// Collect returns the slice of all elements of the Seq.
+func Collect[T any](seq Seq[T]) []T {
+	var res []T
+
+	seq(func(el T) bool {
+		res = append(res, el)
+
+		return true
+	})
+
+	return res
+}
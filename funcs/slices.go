@@ -0,0 +1,168 @@
+// Copyright 2021. The GTL Authors. All rights reserved.
+// https://github.com/modern-dev/gtl
+// Use of this source code is governed by the MIT
+// license that can be found in the LICENSE file.
+
+package funcs
+
+import (
+	"constraints"
+
+	"github.com/modern-dev/gtl/utility"
+)
+
+// Map returns the slice of results of fn applied to every element of s.
+func Map[T any, R any](s []T, fn func(T) R) []R {
+	res := make([]R, len(s))
+
+	for i, el := range s {
+		res[i] = fn(el)
+	}
+
+	return res
+}
+
+// Filter returns the slice of elements of s that satisfy pred, preserving their order.
+func Filter[T any](s []T, pred func(T) bool) []T {
+	var res []T
+
+	for _, el := range s {
+		if pred(el) {
+			res = append(res, el)
+		}
+	}
+
+	return res
+}
+
+// Partition splits s into the elements that satisfy pred and the ones that do not, preserving their order.
+func Partition[T any](s []T, pred func(T) bool) ([]T, []T) {
+	return PartitionSeq(Values(s), pred)
+}
+
+// Chunk splits s into consecutive chunks of the given size, the last chunk may be shorter.
+// The chunks share the memory with s. Panics if size is not positive.
+func Chunk[T any](s []T, size int) [][]T {
+	if size <= 0 {
+		panic("funcs: chunk size must be positive")
+	}
+
+	res := make([][]T, 0, (len(s)+size-1)/size)
+
+	for len(s) > size {
+		res = append(res, s[:size:size])
+		s = s[size:]
+	}
+
+	if len(s) > 0 {
+		res = append(res, s)
+	}
+
+	return res
+}
+
+// Zip returns the pairs of elements of lhs and rhs at the same positions.
+// The result is as long as the shorter of the slices.
+func Zip[T1 any, T2 any](lhs []T1, rhs []T2) []utility.Pair[T1, T2] {
+	n := len(lhs)
+
+	if len(rhs) < n {
+		n = len(rhs)
+	}
+
+	res := make([]utility.Pair[T1, T2], n)
+
+	for i := range res {
+		res[i] = utility.Pair[T1, T2]{First: lhs[i], Second: rhs[i]}
+	}
+
+	return res
+}
+
+// Unzip splits the pairs into the slices of their first and second elements.
+func Unzip[T1 any, T2 any](pairs []utility.Pair[T1, T2]) ([]T1, []T2) {
+	first, second := make([]T1, len(pairs)), make([]T2, len(pairs))
+
+	for i, p := range pairs {
+		first[i], second[i] = p.First, p.Second
+	}
+
+	return first, second
+}
+
+// Flatten concatenates the slices.
+func Flatten[T any](ss [][]T) []T {
+	n := 0
+
+	for _, s := range ss {
+		n += len(s)
+	}
+
+	res := make([]T, 0, n)
+
+	for _, s := range ss {
+		res = append(res, s...)
+	}
+
+	return res
+}
+
+// Reduce folds the elements of s from left to right into an accumulator starting with init.
+func Reduce[T any, A any](s []T, init A, fn func(acc A, el T) A) A {
+	return ReduceSeq(Values(s), init, fn)
+}
+
+// GroupBy groups the elements of s by their keys, preserving the order within each group.
+func GroupBy[T any, K comparable](s []T, key func(T) K) map[K][]T {
+	return GroupBySeq(Values(s), key)
+}
+
+// Distinct returns the elements of s without repetitions, keeping the first occurrences in their order.
+func Distinct[T comparable](s []T) []T {
+	return DistinctSeq(Values(s))
+}
+
+// KeyBy returns the map of the elements of s by their keys. The last element wins for equal keys.
+func KeyBy[T any, K comparable](s []T, key func(T) K) map[K]T {
+	return KeyBySeq(Values(s), key)
+}
+
+// Associate returns the map of the entries produced by fn for the elements of s. The last entry wins for equal keys.
+func Associate[T any, K comparable, V any](s []T, fn func(T) (K, V)) map[K]V {
+	return AssociateSeq(Values(s), fn)
+}
+
+// Any checks if at least one element of s satisfies pred.
+func Any[T any](s []T, pred func(T) bool) bool {
+	return AnySeq(Values(s), pred)
+}
+
+// All checks if all elements of s satisfy pred. It is true for an empty slice.
+func All[T any](s []T, pred func(T) bool) bool {
+	return AllSeq(Values(s), pred)
+}
+
+// None checks if no element of s satisfies pred. It is true for an empty slice.
+func None[T any](s []T, pred func(T) bool) bool {
+	return NoneSeq(Values(s), pred)
+}
+
+// Count returns the number of elements of s that satisfy pred.
+func Count[T any](s []T, pred func(T) bool) int {
+	return CountSeq(Values(s), pred)
+}
+
+// SumBy returns the sum of fn applied to every element of s.
+func SumBy[T any, N Number](s []T, fn func(T) N) N {
+	return SumBySeq(Values(s), fn)
+}
+
+// MinBy returns the first element of s with the least key. Returns false if s is empty.
+func MinBy[T any, K constraints.Ordered](s []T, key func(T) K) (T, bool) {
+	return MinBySeq(Values(s), key)
+}
+
+// MaxBy returns the first element of s with the greatest key. Returns false if s is empty.
+func MaxBy[T any, K constraints.Ordered](s []T, key func(T) K) (T, bool) {
+	return MaxBySeq(Values(s), key)
+}
@@ -0,0 +1,86 @@
+// Copyright 2021. The GTL Authors. All rights reserved.
+// https://github.com/modern-dev/gtl
+// Use of this source code is governed by the MIT
+// license that can be found in the LICENSE file.
+
+package funcs
+
+import (
+	"fmt"
+	"strconv"
+	"testing"
+
+	"github.com/modern-dev/gtl/utility"
+)
+
+func TestMapFilterReduce(t *testing.T) {
+	nums := []int{1, 2, 3, 4, 5, 6}
+
+	squares := Map(nums, func(n int) int { return n * n })
+	assertSlice(squares, []int{1, 4, 9, 16, 25, 36}, t)
+
+	even := Filter(nums, func(n int) bool { return n%2 == 0 })
+	assertSlice(even, []int{2, 4, 6}, t)
+
+	joined := Reduce(nums, "", func(acc string, n int) string { return acc + strconv.Itoa(n) })
+
+	if joined != "123456" {
+		t.Errorf("Expected to get %s, got %s", "123456", joined)
+	}
+
+	if Filter([]int{1, 3}, func(n int) bool { return n%2 == 0 }) != nil {
+		t.Errorf("Expected Filter() to return nil when nothing matches")
+	}
+}
+
+func TestPartitionChunkFlatten(t *testing.T) {
+	small, large := Partition([]int{5, 1, 7, 2, 9}, func(n int) bool { return n < 5 })
+	assertSlice(small, []int{1, 2}, t)
+	assertSlice(large, []int{5, 7, 9}, t)
+
+	chunks := Chunk([]int{1, 2, 3, 4, 5}, 2)
+
+	if fmt.Sprint(chunks) != "[[1 2] [3 4] [5]]" {
+		t.Errorf("Expected to get %s, got %v", "[[1 2] [3 4] [5]]", chunks)
+	}
+
+	// the chunks must not overwrite each other when appended to
+	chunks[0] = append(chunks[0], 42)
+	assertSlice(chunks[1], []int{3, 4}, t)
+
+	assertSlice(Flatten([][]int{{1}, nil, {2, 3}}), []int{1, 2, 3}, t)
+
+	if len(Chunk([]int{}, 3)) != 0 {
+		t.Errorf("Expected no chunks of an empty slice")
+	}
+}
+
+func TestZipUnzip(t *testing.T) {
+	pairs := Zip([]int{1, 2, 3}, []string{"one", "two"})
+
+	if len(pairs) != 2 || pairs[1] != *utility.MakePair(2, "two") {
+		t.Errorf("Expected to get [(1, one) (2, two)], got %v", pairs)
+	}
+
+	nums, names := Unzip(pairs)
+	assertSlice(nums, []int{1, 2}, t)
+	assertSlice(names, []string{"one", "two"}, t)
+}
+
+func TestDistinct(t *testing.T) {
+	assertSlice(Distinct([]string{"b", "a", "b", "c", "a"}), []string{"b", "a", "c"}, t)
+}
+
+func assertSlice[T comparable](got, want []T, t *testing.T) {
+	t.Helper()
+
+	if len(got) != len(want) {
+		t.Fatalf("Expected to get %v, got %v", want, got)
+	}
+
+	for i := range want {
+		if got[i] != want[i] {
+			t.Fatalf("Expected to get %v, got %v", want, got)
+		}
+	}
+}
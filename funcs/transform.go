@@ -0,0 +1,104 @@
+// Copyright 2021. The GTL Authors. All rights reserved.
+// https://github.com/modern-dev/gtl
+// Use of this source code is governed by the MIT
+// license that can be found in the LICENSE file.
+
+package funcs
+
+import "github.com/modern-dev/gtl/utility"
+
+// The lazy counterparts of the slice transforms. They only wrap the iterator,
+// the source is iterated when the result is and no further than the consumer asks for.
+
+// MapSeq returns the Seq of results of fn applied to every element of seq.
+func MapSeq[T any, R any](seq Seq[T], fn func(T) R) Seq[R] {
+	return func(yield func(R) bool) {
+		seq(func(el T) bool {
+			return yield(fn(el))
+		})
+	}
+}
+
+// FilterSeq returns the Seq of elements of seq that satisfy pred.
+func FilterSeq[T any](seq Seq[T], pred func(T) bool) Seq[T] {
+	return func(yield func(T) bool) {
+		seq(func(el T) bool {
+			return !pred(el) || yield(el)
+		})
+	}
+}
+
+// ChunkSeq returns the Seq of consecutive chunks of seq of the given size, the last chunk may be shorter.
+// Every chunk is a fresh slice. Panics if size is not positive.
+func ChunkSeq[T any](seq Seq[T], size int) Seq[[]T] {
+	if size <= 0 {
+		panic("funcs: chunk size must be positive")
+	}
+
+	return func(yield func([]T) bool) {
+		chunk := make([]T, 0, size)
+		stopped := false
+
+		seq(func(el T) bool {
+			if chunk = append(chunk, el); len(chunk) < size {
+				return true
+			}
+
+			full := chunk
+			chunk = make([]T, 0, size)
+			stopped = !yield(full)
+
+			return !stopped
+		})
+
+		if !stopped && len(chunk) > 0 {
+			yield(chunk)
+		}
+	}
+}
+
+// ZipSeq returns the Seq of pairs of elements of lhs and the elements returned by next at the same positions.
+// It ends with the shorter of the sequences.
+// The second sequence is a pull iterator, see PullValues, as two Seq cannot be advanced in step
+// without running one of them in a goroutine. next is called once per element of lhs
+// and never after the consumer stops.
+func ZipSeq[T1 any, T2 any](lhs Seq[T1], next func() (T2, bool)) Seq[utility.Pair[T1, T2]] {
+	return func(yield func(utility.Pair[T1, T2]) bool) {
+		lhs(func(first T1) bool {
+			second, ok := next()
+
+			return ok && yield(utility.Pair[T1, T2]{First: first, Second: second})
+		})
+	}
+}
+
+// UnzipSeq splits the pairs of seq into the slices of their first and second elements.
+func UnzipSeq[T1 any, T2 any](seq Seq[utility.Pair[T1, T2]]) ([]T1, []T2) {
+	var (
+		first  []T1
+		second []T2
+	)
+
+	seq(func(p utility.Pair[T1, T2]) bool {
+		first, second = append(first, p.First), append(second, p.Second)
+
+		return true
+	})
+
+	return first, second
+}
+
+// FlattenSeq returns the Seq of the elements of all slices of seq in order.
+func FlattenSeq[T any](seq Seq[[]T]) Seq[T] {
+	return func(yield func(T) bool) {
+		seq(func(s []T) bool {
+			for _, el := range s {
+				if !yield(el) {
+					return false
+				}
+			}
+
+			return true
+		})
+	}
+}
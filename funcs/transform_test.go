@@ -0,0 +1,120 @@
+// Copyright 2021. The GTL Authors. All rights reserved.
+// https://github.com/modern-dev/gtl
+// Use of this source code is governed by the MIT
+// license that can be found in the LICENSE file.
+
+package funcs
+
+import (
+	"fmt"
+	"strconv"
+	"testing"
+
+	"github.com/modern-dev/gtl/utility"
+)
+
+// naturals returns the endless Seq of 1, 2, 3, ... counting the produced elements.
+func naturals(produced *int) Seq[int] {
+	return func(yield func(int) bool) {
+		for n := 1; ; n++ {
+			*produced++
+
+			if !yield(n) {
+				return
+			}
+		}
+	}
+}
+
+// take returns at most n first elements of seq.
+func take[T any](seq Seq[T], n int) []T {
+	var res []T
+
+	seq(func(el T) bool {
+		res = append(res, el)
+
+		return len(res) < n
+	})
+
+	return res
+}
+
+func TestMapFilterSeq(t *testing.T) {
+	produced := 0
+	labels := MapSeq(FilterSeq(naturals(&produced), func(n int) bool { return n%3 == 0 }),
+		func(n int) string { return "#" + strconv.Itoa(n) })
+
+	if produced != 0 {
+		t.Errorf("Expected nothing to be produced before the iteration, got %d", produced)
+	}
+
+	assertSlice(take(labels, 3), []string{"#3", "#6", "#9"}, t)
+
+	// the source is not asked for more than needed
+	if produced != 9 {
+		t.Errorf("Expected to get %d, got %d", 9, produced)
+	}
+
+	assertSlice(Collect(MapSeq(Values([]int{}), strconv.Itoa)), nil, t)
+}
+
+func TestChunkFlattenSeq(t *testing.T) {
+	chunks := Collect(ChunkSeq(Values([]int{1, 2, 3, 4, 5}), 2))
+
+	if got := fmt.Sprint(chunks); got != "[[1 2] [3 4] [5]]" {
+		t.Errorf("Expected to get %s, got %s", "[[1 2] [3 4] [5]]", got)
+	}
+
+	// the chunks must not share memory
+	chunks[0][1] = 42
+
+	if chunks[1][0] != 3 {
+		t.Errorf("Expected the chunks to be independent")
+	}
+
+	produced := 0
+
+	if got := fmt.Sprint(take(ChunkSeq(naturals(&produced), 3), 2)); got != "[[1 2 3] [4 5 6]]" || produced != 6 {
+		t.Errorf("Expected to get ([[1 2 3] [4 5 6]], 6), got (%s, %d)", got, produced)
+	}
+
+	flat := FlattenSeq(Values([][]int{{1, 2}, {}, {3}, {4, 5}}))
+
+	assertSlice(Collect(flat), []int{1, 2, 3, 4, 5}, t)
+	assertSlice(take(flat, 3), []int{1, 2, 3}, t)
+
+	defer func() {
+		if recover() == nil {
+			t.Errorf("Expected ChunkSeq() to panic for a non-positive size")
+		}
+	}()
+
+	ChunkSeq(Values([]int{1}), 0)
+}
+
+func TestZipUnzipSeq(t *testing.T) {
+	produced := 0
+	pairs := ZipSeq(naturals(&produced), PullValues([]string{"a", "b", "c"}))
+
+	if got := fmt.Sprint(Collect(pairs)); got != "[(1, a) (2, b) (3, c)]" {
+		t.Errorf("Expected to get %s, got %s", "[(1, a) (2, b) (3, c)]", got)
+	}
+
+	pulled := 0
+	letters := PullValues([]string{"a", "b", "c"})
+	next := func() (string, bool) {
+		pulled++
+
+		return letters()
+	}
+
+	// next is not called past the point where the consumer stops
+	if got := fmt.Sprint(take(ZipSeq(Values([]int{1, 2, 3}), next), 2)); got != "[(1, a) (2, b)]" || pulled != 2 {
+		t.Errorf("Expected to get ([(1, a) (2, b)], 2), got (%s, %d)", got, pulled)
+	}
+
+	nums, names := UnzipSeq(Values([]utility.Pair[int, string]{{First: 1, Second: "a"}, {First: 2, Second: "b"}}))
+
+	assertSlice(nums, []int{1, 2}, t)
+	assertSlice(names, []string{"a", "b"}, t)
+}
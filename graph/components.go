@@ -0,0 +1,160 @@
+// Copyright 2021. The GTL Authors. All rights reserved.
+// https://github.com/modern-dev/gtl
+// Use of this source code is governed by the MIT
+// license that can be found in the LICENSE file.
+
+package graph
+
+import (
+	"github.com/modern-dev/gtl/containers/stack"
+)
+
+// StronglyConnectedComponents finds the strongly connected components of a directed graph with Tarjan's algorithm.
+// The components are returned in reverse topological order of the condensation:
+// no edge goes from a component to a later one.
+// Complexity - O(V + E).
+func StronglyConnectedComponents[W Weight](g *Graph[W]) ([][]int, error) {
+	if !g.directed {
+		return nil, ErrNotDirected
+	}
+
+	var (
+		components [][]int
+		counter    int
+		index      = make([]int, g.Order())
+		low        = make([]int, g.Order())
+		onStack    = make([]bool, g.Order())
+		s          = stack.NewStack[int]()
+	)
+
+	var connect func(v int)
+
+	connect = func(v int) {
+		counter++
+		index[v], low[v] = counter, counter
+		s.Push(v)
+		onStack[v] = true
+
+		for _, e := range g.adj[v] {
+			if index[e.To] == 0 {
+				connect(e.To)
+				low[v] = minInt(low[v], low[e.To])
+			} else if onStack[e.To] {
+				low[v] = minInt(low[v], index[e.To])
+			}
+		}
+
+		if low[v] != index[v] {
+			return
+		}
+
+		var component []int
+
+		for {
+			w := s.Pop()
+			onStack[w] = false
+			component = append(component, w)
+
+			if w == v {
+				break
+			}
+		}
+
+		components = append(components, component)
+	}
+
+	for v := range g.adj {
+		if index[v] == 0 {
+			connect(v)
+		}
+	}
+
+	return components, nil
+}
+
+// BridgesAndArticulationPoints finds the edges and the vertices of an undirected graph
+// whose removal increases the number of connected components.
+// Parallel edges are never bridges.
+// Complexity - O(V + E).
+func BridgesAndArticulationPoints[W Weight](g *Graph[W]) ([]Edge[W], []int, error) {
+	if g.directed {
+		return nil, nil, ErrNotUndirected
+	}
+
+	var (
+		bridges []Edge[W]
+		points  []int
+		counter int
+		index   = make([]int, g.Order())
+		low     = make([]int, g.Order())
+	)
+
+	var visit func(v, parentEdge int)
+
+	visit = func(v, parentEdge int) {
+		counter++
+		index[v], low[v] = counter, counter
+		children, cut := 0, false
+
+		for _, e := range g.adj[v] {
+			if e.id == parentEdge {
+				continue
+			}
+
+			if index[e.To] != 0 {
+				low[v] = minInt(low[v], index[e.To])
+
+				continue
+			}
+
+			children++
+			visit(e.To, e.id)
+			low[v] = minInt(low[v], low[e.To])
+
+			if low[e.To] > index[v] {
+				bridges = append(bridges, e)
+			}
+
+			if low[e.To] >= index[v] && parentEdge >= 0 {
+				cut = true
+			}
+		}
+
+		if cut || parentEdge < 0 && children > 1 {
+			points = append(points, v)
+		}
+	}
+
+	for v := range g.adj {
+		if index[v] == 0 {
+			visit(v, -1)
+		}
+	}
+
+	return bridges, points, nil
+}
+
+// Bridges finds the edges of an undirected graph whose removal increases the number of connected components.
+// Complexity - O(V + E).
+func Bridges[W Weight](g *Graph[W]) ([]Edge[W], error) {
+	bridges, _, err := BridgesAndArticulationPoints(g)
+
+	return bridges, err
+}
+
+// ArticulationPoints finds the vertices of an undirected graph whose removal
+// increases the number of connected components.
+// Complexity - O(V + E).
+func ArticulationPoints[W Weight](g *Graph[W]) ([]int, error) {
+	_, points, err := BridgesAndArticulationPoints(g)
+
+	return points, err
+}
+
+func minInt(a, b int) int {
+	if a < b {
+		return a
+	}
+
+	return b
+}
@@ -0,0 +1,70 @@
+// Copyright 2021. The GTL Authors. All rights reserved.
+// https://github.com/modern-dev/gtl
+// Use of this source code is governed by the MIT
+// license that can be found in the LICENSE file.
+
+package graph
+
+import (
+	"fmt"
+	"sort"
+	"testing"
+)
+
+func TestStronglyConnectedComponents(t *testing.T) {
+	g := NewDirected[int](8)
+
+	for _, e := range [][2]int{{0, 1}, {1, 2}, {2, 0}, {2, 3}, {3, 4}, {4, 5}, {5, 3}, {6, 5}, {6, 7}, {7, 6}} {
+		g.AddEdge(e[0], e[1], 1)
+	}
+
+	components, err := StronglyConnectedComponents(g)
+
+	if err != nil {
+		t.Fatalf("Expected no error, got %v", err)
+	}
+
+	for _, c := range components {
+		sort.Ints(c)
+	}
+
+	if got := fmt.Sprint(components); got != "[[3 4 5] [0 1 2] [6 7]]" {
+		t.Errorf("Expected components %s, got %s", "[[3 4 5] [0 1 2] [6 7]]", got)
+	}
+
+	if _, err := StronglyConnectedComponents(tree()); err != ErrNotDirected {
+		t.Errorf("Expected to get %v, got %v", ErrNotDirected, err)
+	}
+}
+
+func TestBridgesAndArticulationPoints(t *testing.T) {
+	// a triangle 0-1-2 hanging on the path 2-3-4 with a doubled edge 4-5
+	g := NewUndirected[int](6)
+
+	for _, e := range [][2]int{{0, 1}, {1, 2}, {2, 0}, {2, 3}, {3, 4}, {4, 5}, {4, 5}} {
+		g.AddEdge(e[0], e[1], 1)
+	}
+
+	bridges, points, err := BridgesAndArticulationPoints(g)
+
+	if err != nil {
+		t.Fatalf("Expected no error, got %v", err)
+	}
+
+	sort.Ints(points)
+
+	if got := fmt.Sprint(points); got != "[2 3 4]" {
+		t.Errorf("Expected articulation points %s, got %s", "[2 3 4]", got)
+	}
+
+	sort.Slice(bridges, func(i, j int) bool { return bridges[i].From < bridges[j].From })
+	assertEdges(bridges, "[2->3(1) 3->4(1)]", t)
+
+	if points, _ := ArticulationPoints(tree()); len(points) != 3 {
+		t.Errorf("Expected %d articulation points in a tree, got %v", 3, points)
+	}
+
+	if bridges, _ := Bridges(tree()); len(bridges) != tree().Size() {
+		t.Errorf("Expected every edge of a tree to be a bridge, got %v", bridges)
+	}
+}
@@ -0,0 +1,148 @@
+// Copyright 2021. The GTL Authors. All rights reserved.
+// https://github.com/modern-dev/gtl
+// Use of this source code is governed by the MIT
+// license that can be found in the LICENSE file.
+
+package graph
+
+import (
+	"constraints"
+	"errors"
+	"fmt"
+)
+
+var (
+	// ErrNotDirected is returned by the algorithms that require a directed graph.
+	ErrNotDirected = errors.New("graph: the graph must be directed")
+	// ErrNotUndirected is returned by the algorithms that require an undirected graph.
+	ErrNotUndirected = errors.New("graph: the graph must be undirected")
+	// ErrCycle is returned by TopologicalSort if the graph has a cycle.
+	ErrCycle = errors.New("graph: the graph has a cycle")
+	// ErrNegativeWeight is returned by the algorithms that require non-negative weights.
+	ErrNegativeWeight = errors.New("graph: the graph has a negative weight")
+	// ErrNegativeCycle is returned by BellmanFord if a negative cycle is reachable from the source.
+	ErrNegativeCycle = errors.New("graph: the graph has a negative cycle")
+)
+
+type (
+	// Weight is a constraint for the types of edge weights.
+	Weight interface {
+		constraints.Integer | constraints.Float
+	}
+
+	// Edge is a weighted edge between two vertices.
+	// In an undirected graph every edge is reachable from both ends, with From being the vertex it was reached from.
+	Edge[W Weight] struct {
+		From, To int
+		Weight   W
+		// id identifies both halves of an undirected edge
+		id int
+	}
+
+	// Graph is a weighted graph represented with adjacency lists.
+	// Vertices are integers in [0, Order()), so they may be used as indexes of slices or union_find.DisjointSet.
+	// Parallel edges and loops are allowed.
+	Graph[W Weight] struct {
+		adj      [][]Edge[W]
+		directed bool
+		edges    int
+	}
+)
+
+// NewDirected creates a directed graph with n vertices and no edges.
+func NewDirected[W Weight](n int) *Graph[W] {
+	return &Graph[W]{adj: make([][]Edge[W], n), directed: true}
+}
+
+// NewUndirected creates an undirected graph with n vertices and no edges.
+func NewUndirected[W Weight](n int) *Graph[W] {
+	return &Graph[W]{adj: make([][]Edge[W], n)}
+}
+
+// Directed checks if the graph is directed.
+// Complexity - O(1).
+func (g *Graph[W]) Directed() bool {
+	return g.directed
+}
+
+// Order returns the number of vertices.
+// Complexity - O(1).
+func (g *Graph[W]) Order() int {
+	return len(g.adj)
+}
+
+// Size returns the number of edges. An undirected edge is counted once.
+// Complexity - O(1).
+func (g *Graph[W]) Size() int {
+	return g.edges
+}
+
+// AddVertex adds a vertex without edges and returns it.
+// Complexity - amortized O(1).
+func (g *Graph[W]) AddVertex() int {
+	g.adj = append(g.adj, nil)
+
+	return len(g.adj) - 1
+}
+
+// AddEdge adds an edge with the given weight. An undirected edge connects the vertices in both directions.
+// Panics if a vertex is out of range.
+// Complexity - amortized O(1).
+func (g *Graph[W]) AddEdge(from, to int, weight W) {
+	g.mustHaveVertex(from)
+	g.mustHaveVertex(to)
+
+	g.adj[from] = append(g.adj[from], Edge[W]{from, to, weight, g.edges})
+
+	if !g.directed && from != to {
+		g.adj[to] = append(g.adj[to], Edge[W]{to, from, weight, g.edges})
+	}
+
+	g.edges++
+}
+
+// Neighbors returns the edges leaving the vertex. The slice must not be modified.
+// Complexity - O(1).
+func (g *Graph[W]) Neighbors(v int) []Edge[W] {
+	g.mustHaveVertex(v)
+
+	return g.adj[v]
+}
+
+// Edges returns all edges of the graph. An undirected edge is returned once.
+// Complexity - O(V + E).
+func (g *Graph[W]) Edges() []Edge[W] {
+	res := make([]Edge[W], 0, g.edges)
+
+	for v := range g.adj {
+		for _, e := range g.adj[v] {
+			if g.directed || e.From <= e.To {
+				res = append(res, e)
+			}
+		}
+	}
+
+	return res
+}
+
+// Reverse returns the graph with all edges of a directed graph reversed.
+// Complexity - O(V + E).
+func (g *Graph[W]) Reverse() (*Graph[W], error) {
+	if !g.directed {
+		return nil, ErrNotDirected
+	}
+
+	rev := NewDirected[W](g.Order())
+
+	for _, e := range g.Edges() {
+		rev.AddEdge(e.To, e.From, e.Weight)
+	}
+
+	return rev, nil
+}
+
+func (g *Graph[W]) mustHaveVertex(v int) {
+	if v < 0 || v >= len(g.adj) {
+		panic(fmt.Sprintf("graph: vertex %d out of range [0, %d)", v, len(g.adj)))
+	}
+}
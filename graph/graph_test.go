@@ -0,0 +1,63 @@
+// Copyright 2021. The GTL Authors. All rights reserved.
+// https://github.com/modern-dev/gtl
+// Use of this source code is governed by the MIT
+// license that can be found in the LICENSE file.
+
+package graph
+
+import (
+	"fmt"
+	"testing"
+)
+
+func TestGraph(t *testing.T) {
+	g := NewUndirected[int](2)
+	v := g.AddVertex()
+
+	g.AddEdge(0, 1, 5)
+	g.AddEdge(1, v, 7)
+	g.AddEdge(v, v, 1)
+
+	if g.Order() != 3 || g.Size() != 3 || g.Directed() {
+		t.Errorf("Expected an undirected graph with 3 vertices and 3 edges, got %d and %d", g.Order(), g.Size())
+	}
+
+	if n := len(g.Neighbors(1)); n != 2 {
+		t.Errorf("Expected vertex 1 to have %d neighbors, got %d", 2, n)
+	}
+
+	assertEdges(g.Edges(), "[0->1(5) 1->2(7) 2->2(1)]", t)
+
+	if _, err := g.Reverse(); err != ErrNotDirected {
+		t.Errorf("Expected to get %v, got %v", ErrNotDirected, err)
+	}
+
+	d := NewDirected[int](3)
+	d.AddEdge(0, 1, 1)
+	d.AddEdge(0, 2, 2)
+
+	rev, _ := d.Reverse()
+	assertEdges(rev.Edges(), "[1->0(1) 2->0(2)]", t)
+
+	defer func() {
+		if recover() == nil {
+			t.Errorf("Expected AddEdge() with an unknown vertex to panic")
+		}
+	}()
+
+	d.AddEdge(0, 3, 1)
+}
+
+func assertEdges[W Weight](edges []Edge[W], expected string, t *testing.T) {
+	t.Helper()
+
+	res := make([]string, len(edges))
+
+	for i, e := range edges {
+		res[i] = fmt.Sprintf("%d->%d(%v)", e.From, e.To, e.Weight)
+	}
+
+	if got := fmt.Sprint(res); got != expected {
+		t.Errorf("Expected edges %s, got %s", expected, got)
+	}
+}
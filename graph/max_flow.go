@@ -0,0 +1,144 @@
+// Copyright 2021. The GTL Authors. All rights reserved.
+// https://github.com/modern-dev/gtl
+// Use of this source code is governed by the MIT
+// license that can be found in the LICENSE file.
+
+package graph
+
+import (
+	"github.com/modern-dev/gtl/containers/deque"
+)
+
+type (
+	// flowNetwork is the residual network of Dinic's algorithm.
+	// The arcs are stored in pairs, so the reverse of arc i is arc i^1.
+	flowNetwork[W Weight] struct {
+		arcs  []arc[W]
+		adj   [][]int
+		level []int
+		next  []int
+	}
+
+	arc[W Weight] struct {
+		to       int
+		residual W
+	}
+)
+
+// MaxFlow finds the maximum flow from the source to the sink with Dinic's algorithm.
+// Edge weights are the capacities, an undirected edge carries flow in either direction up to its capacity.
+// Returns ErrNegativeWeight if a capacity is negative.
+// Complexity - O(V^2 * E).
+func MaxFlow[W Weight](g *Graph[W], source, sink int) (W, error) {
+	g.mustHaveVertex(source)
+	g.mustHaveVertex(sink)
+
+	if err := g.mustNotHaveNegativeWeights(); err != nil {
+		return 0, err
+	}
+
+	if source == sink {
+		return 0, nil
+	}
+
+	n := newFlowNetwork(g)
+
+	var flow W
+
+	for n.buildLevels(source, sink) {
+		for v := range n.next {
+			n.next[v] = 0
+		}
+
+		for {
+			pushed, ok := n.push(source, sink, 0, true)
+
+			if !ok {
+				break
+			}
+
+			flow += pushed
+		}
+	}
+
+	return flow, nil
+}
+
+func newFlowNetwork[W Weight](g *Graph[W]) *flowNetwork[W] {
+	n := &flowNetwork[W]{
+		adj:   make([][]int, g.Order()),
+		level: make([]int, g.Order()),
+		next:  make([]int, g.Order()),
+	}
+
+	for _, e := range g.Edges() {
+		var back W
+
+		if !g.directed {
+			back = e.Weight
+		}
+
+		n.adj[e.From] = append(n.adj[e.From], len(n.arcs))
+		n.arcs = append(n.arcs, arc[W]{e.To, e.Weight})
+		n.adj[e.To] = append(n.adj[e.To], len(n.arcs))
+		n.arcs = append(n.arcs, arc[W]{e.From, back})
+	}
+
+	return n
+}
+
+// buildLevels computes the BFS levels of the residual network. Returns false if the sink is unreachable.
+func (n *flowNetwork[W]) buildLevels(source, sink int) bool {
+	for v := range n.level {
+		n.level[v] = -1
+	}
+
+	frontier := deque.NewDeque[int]()
+	frontier.PushBack(source)
+	n.level[source] = 0
+
+	for !frontier.Empty() {
+		v := frontier.PopFront()
+
+		for _, i := range n.adj[v] {
+			if a := n.arcs[i]; a.residual > 0 && n.level[a.to] < 0 {
+				n.level[a.to] = n.level[v] + 1
+				frontier.PushBack(a.to)
+			}
+		}
+	}
+
+	return n.level[sink] >= 0
+}
+
+// push sends a blocking flow step along the level graph and returns the pushed amount.
+// unbounded tells that limit is not set yet, which is the case at the source.
+func (n *flowNetwork[W]) push(v, sink int, limit W, unbounded bool) (W, bool) {
+	if v == sink {
+		return limit, true
+	}
+
+	for ; n.next[v] < len(n.adj[v]); n.next[v]++ {
+		i := n.adj[v][n.next[v]]
+		a := n.arcs[i]
+
+		if a.residual <= 0 || n.level[a.to] != n.level[v]+1 {
+			continue
+		}
+
+		amount := a.residual
+
+		if !unbounded && limit < amount {
+			amount = limit
+		}
+
+		if pushed, ok := n.push(a.to, sink, amount, false); ok && pushed > 0 {
+			n.arcs[i].residual -= pushed
+			n.arcs[i^1].residual += pushed
+
+			return pushed, true
+		}
+	}
+
+	return 0, false
+}
@@ -0,0 +1,45 @@
+// Copyright 2021. The GTL Authors. All rights reserved.
+// https://github.com/modern-dev/gtl
+// Use of this source code is governed by the MIT
+// license that can be found in the LICENSE file.
+
+package graph
+
+import "testing"
+
+func TestMaxFlow(t *testing.T) {
+	// the classic CLRS network with the maximum flow of 23
+	g := NewDirected[int](6)
+
+	for _, e := range [][3]int{{0, 1, 16}, {0, 2, 13}, {1, 2, 10}, {2, 1, 4}, {1, 3, 12},
+		{3, 2, 9}, {2, 4, 14}, {4, 3, 7}, {3, 5, 20}, {4, 5, 4}} {
+		g.AddEdge(e[0], e[1], e[2])
+	}
+
+	assertFlow(g, 0, 5, 23, t)
+	assertFlow(g, 5, 0, 0, t)
+	assertFlow(g, 0, 0, 0, t)
+
+	u := NewUndirected[float64](4)
+	u.AddEdge(0, 1, 1.5)
+	u.AddEdge(1, 3, 1)
+	u.AddEdge(2, 1, 2)
+	u.AddEdge(2, 3, 3)
+	u.AddEdge(0, 2, 1)
+
+	assertFlow(u, 3, 0, 2.5, t)
+
+	u.AddEdge(0, 3, -1)
+
+	if _, err := MaxFlow(u, 0, 3); err != ErrNegativeWeight {
+		t.Errorf("Expected to get %v, got %v", ErrNegativeWeight, err)
+	}
+}
+
+func assertFlow[W Weight](g *Graph[W], source, sink int, expected W, t *testing.T) {
+	t.Helper()
+
+	if flow, err := MaxFlow(g, source, sink); err != nil || flow != expected {
+		t.Errorf("Expected flow %v from %d to %d, got %v (%v)", expected, source, sink, flow, err)
+	}
+}
@@ -0,0 +1,91 @@
+// Copyright 2021. The GTL Authors. All rights reserved.
+// https://github.com/modern-dev/gtl
+// Use of this source code is governed by the MIT
+// license that can be found in the LICENSE file.
+
+package graph
+
+import (
+	"sort"
+
+	"github.com/modern-dev/gtl/containers/priority_queue"
+	"github.com/modern-dev/gtl/containers/union-find"
+)
+
+// Kruskal finds the minimum spanning forest of an undirected graph with union_find.DisjointSet.
+// Returns the edges of the forest and their total weight.
+// Complexity - O(E log E).
+func Kruskal[W Weight](g *Graph[W]) ([]Edge[W], W, error) {
+	if g.directed {
+		return nil, 0, ErrNotUndirected
+	}
+
+	edges := g.Edges()
+
+	sort.SliceStable(edges, func(i, j int) bool {
+		return edges[i].Weight < edges[j].Weight
+	})
+
+	var (
+		forest []Edge[W]
+		total  W
+	)
+
+	ds := union_find.NewDisjointSet(g.Order())
+
+	for _, e := range edges {
+		if ds.Union(e.From, e.To) {
+			forest = append(forest, e)
+			total += e.Weight
+		}
+	}
+
+	return forest, total, nil
+}
+
+// Prim finds the minimum spanning forest of an undirected graph growing a tree from every unvisited vertex.
+// Returns the edges of the forest and their total weight.
+// Complexity - O(E log E).
+func Prim[W Weight](g *Graph[W]) ([]Edge[W], W, error) {
+	if g.directed {
+		return nil, 0, ErrNotUndirected
+	}
+
+	var (
+		forest []Edge[W]
+		total  W
+	)
+
+	inTree := make([]bool, g.Order())
+	pq := priority_queue.NewPriorityQueueWithComparatorFunc[Edge[W]](func(lhs, rhs Edge[W]) bool {
+		return lhs.Weight > rhs.Weight
+	})
+
+	add := func(v int) {
+		inTree[v] = true
+
+		for _, e := range g.adj[v] {
+			if !inTree[e.To] {
+				pq.Push(e)
+			}
+		}
+	}
+
+	for root := range g.adj {
+		if inTree[root] {
+			continue
+		}
+
+		add(root)
+
+		for !pq.Empty() {
+			if e := pq.Pop(); !inTree[e.To] {
+				forest = append(forest, e)
+				total += e.Weight
+				add(e.To)
+			}
+		}
+	}
+
+	return forest, total, nil
+}
@@ -0,0 +1,55 @@
+// Copyright 2021. The GTL Authors. All rights reserved.
+// https://github.com/modern-dev/gtl
+// Use of this source code is governed by the MIT
+// license that can be found in the LICENSE file.
+
+package graph
+
+import (
+	"math/rand"
+	"testing"
+)
+
+func TestMinimumSpanningForest(t *testing.T) {
+	g := NewUndirected[float64](6)
+	g.AddEdge(0, 1, 4)
+	g.AddEdge(0, 2, 1)
+	g.AddEdge(1, 2, 2)
+	g.AddEdge(1, 3, 5)
+	g.AddEdge(2, 3, 8)
+	// a separate component
+	g.AddEdge(4, 5, 0.5)
+	g.AddEdge(4, 5, 0.25)
+
+	for name, mst := range map[string]func(*Graph[float64]) ([]Edge[float64], float64, error){
+		"Kruskal": Kruskal[float64],
+		"Prim":    Prim[float64],
+	} {
+		forest, total, err := mst(g)
+
+		if err != nil || len(forest) != 4 || total != 8.25 {
+			t.Errorf("%s: expected 4 edges of total weight %f, got %d of %f (%v)", name, 8.25, len(forest), total, err)
+		}
+
+		if _, _, err := mst(NewDirected[float64](1)); err != ErrNotUndirected {
+			t.Errorf("%s: expected to get %v, got %v", name, ErrNotUndirected, err)
+		}
+	}
+}
+
+func TestKruskalMatchesPrim(t *testing.T) {
+	rnd := rand.New(rand.NewSource(3))
+	g := NewUndirected[int](50)
+
+	for i := 0; i < 300; i++ {
+		g.AddEdge(rnd.Intn(50), rnd.Intn(50), rnd.Intn(100))
+	}
+
+	kruskal, kruskalTotal, _ := Kruskal(g)
+	prim, primTotal, _ := Prim(g)
+
+	if len(kruskal) != len(prim) || kruskalTotal != primTotal {
+		t.Errorf("Expected forests to match, got %d edges of %d and %d edges of %d",
+			len(kruskal), kruskalTotal, len(prim), primTotal)
+	}
+}
@@ -0,0 +1,210 @@
+// Copyright 2021. The GTL Authors. All rights reserved.
+// https://github.com/modern-dev/gtl
+// Use of this source code is governed by the MIT
+// license that can be found in the LICENSE file.
+
+package graph
+
+import (
+	"github.com/modern-dev/gtl/containers/priority_queue"
+)
+
+type (
+	// ShortestPaths is the tree of shortest paths from a single source.
+	ShortestPaths[W Weight] struct {
+		// Dist is the length of the shortest path to every vertex, zero for unreachable ones.
+		Dist []W
+		// Prev is the previous vertex on the shortest path to every vertex, -1 for the source and unreachable ones.
+		Prev    []int
+		reached []bool
+	}
+
+	// candidate is a tentative distance to a vertex in the priority queue.
+	candidate[W Weight] struct {
+		v    int
+		dist W
+	}
+)
+
+func newShortestPaths[W Weight](n, source int) *ShortestPaths[W] {
+	sp := &ShortestPaths[W]{
+		Dist:    make([]W, n),
+		Prev:    make([]int, n),
+		reached: make([]bool, n),
+	}
+
+	for v := range sp.Prev {
+		sp.Prev[v] = -1
+	}
+
+	sp.reached[source] = true
+
+	return sp
+}
+
+// Reachable checks if there is a path from the source to the vertex.
+func (sp *ShortestPaths[W]) Reachable(v int) bool {
+	return sp.reached[v]
+}
+
+// PathTo returns the vertices of the shortest path from the source to the vertex, including both ends.
+// Returns nil if the vertex is unreachable.
+// Complexity - O(L), where L is the length of the path.
+func (sp *ShortestPaths[W]) PathTo(v int) []int {
+	if !sp.Reachable(v) {
+		return nil
+	}
+
+	var path []int
+
+	for ; v >= 0; v = sp.Prev[v] {
+		path = append(path, v)
+	}
+
+	for i, j := 0, len(path)-1; i < j; i, j = i+1, j-1 {
+		path[i], path[j] = path[j], path[i]
+	}
+
+	return path
+}
+
+// Dijkstra finds the shortest paths from the source in a graph with non-negative weights.
+// Returns ErrNegativeWeight if the graph has a negative weight.
+// Complexity - O((V + E) log V).
+func Dijkstra[W Weight](g *Graph[W], source int) (*ShortestPaths[W], error) {
+	g.mustHaveVertex(source)
+
+	if err := g.mustNotHaveNegativeWeights(); err != nil {
+		return nil, err
+	}
+
+	sp := newShortestPaths[W](g.Order(), source)
+	done := make([]bool, g.Order())
+	pq := newCandidateQueue[W]()
+
+	pq.Push(candidate[W]{source, 0})
+
+	for !pq.Empty() {
+		c := pq.Pop()
+
+		if done[c.v] {
+			continue
+		}
+
+		done[c.v] = true
+
+		for _, e := range g.adj[c.v] {
+			if dist := c.dist + e.Weight; !done[e.To] && (!sp.reached[e.To] || dist < sp.Dist[e.To]) {
+				sp.relax(e, dist)
+				pq.Push(candidate[W]{e.To, dist})
+			}
+		}
+	}
+
+	return sp, nil
+}
+
+// BellmanFord finds the shortest paths from the source in a graph that may have negative weights.
+// Returns ErrNegativeCycle if a negative cycle is reachable from the source.
+// An undirected edge with a negative weight is a negative cycle by itself.
+// Complexity - O(V * E).
+func BellmanFord[W Weight](g *Graph[W], source int) (*ShortestPaths[W], error) {
+	g.mustHaveVertex(source)
+
+	sp := newShortestPaths[W](g.Order(), source)
+
+	relax := func() bool {
+		changed := false
+
+		for v := range g.adj {
+			if !sp.reached[v] {
+				continue
+			}
+
+			for _, e := range g.adj[v] {
+				if dist := sp.Dist[v] + e.Weight; !sp.reached[e.To] || dist < sp.Dist[e.To] {
+					sp.relax(e, dist)
+					changed = true
+				}
+			}
+		}
+
+		return changed
+	}
+
+	for i := 1; i < g.Order(); i++ {
+		if !relax() {
+			return sp, nil
+		}
+	}
+
+	if relax() {
+		return nil, ErrNegativeCycle
+	}
+
+	return sp, nil
+}
+
+// AStar finds the shortest path from the source to the target in a graph with non-negative weights
+// guided by the heuristic estimate of the remaining distance to the target.
+// The heuristic must be consistent, i.e. never overestimate and never decrease along an edge by more than its weight,
+// for the path to be the shortest. A zero heuristic turns AStar into Dijkstra.
+// Returns the path including both ends, its length and false if the target is unreachable.
+// Complexity - O((V + E) log V) in the worst case.
+func AStar[W Weight](g *Graph[W], source, target int, heuristic func(v int) W) ([]int, W, bool) {
+	g.mustHaveVertex(source)
+	g.mustHaveVertex(target)
+
+	sp := newShortestPaths[W](g.Order(), source)
+	done := make([]bool, g.Order())
+	pq := newCandidateQueue[W]()
+
+	pq.Push(candidate[W]{source, heuristic(source)})
+
+	for !pq.Empty() {
+		c := pq.Pop()
+
+		if c.v == target {
+			return sp.PathTo(target), sp.Dist[target], true
+		}
+
+		if done[c.v] {
+			continue
+		}
+
+		done[c.v] = true
+
+		for _, e := range g.adj[c.v] {
+			if dist := sp.Dist[c.v] + e.Weight; !done[e.To] && (!sp.reached[e.To] || dist < sp.Dist[e.To]) {
+				sp.relax(e, dist)
+				pq.Push(candidate[W]{e.To, dist + heuristic(e.To)})
+			}
+		}
+	}
+
+	return nil, 0, false
+}
+
+// relax records the edge as the last one on the shortest path to its end found so far.
+func (sp *ShortestPaths[W]) relax(e Edge[W], dist W) {
+	sp.Dist[e.To], sp.Prev[e.To], sp.reached[e.To] = dist, e.From, true
+}
+
+// newCandidateQueue creates a priority queue with the closest candidate on top.
+func newCandidateQueue[W Weight]() *priority_queue.PriorityQueue[candidate[W]] {
+	return priority_queue.NewPriorityQueueWithComparatorFunc[candidate[W]](func(lhs, rhs candidate[W]) bool {
+		return lhs.dist > rhs.dist
+	})
+}
+
+func (g *Graph[W]) mustNotHaveNegativeWeights() error {
+	for v := range g.adj {
+		for _, e := range g.adj[v] {
+			if e.Weight < 0 {
+				return ErrNegativeWeight
+			}
+		}
+	}
+
+	return nil
+}
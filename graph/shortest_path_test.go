@@ -0,0 +1,114 @@
+// Copyright 2021. The GTL Authors. All rights reserved.
+// https://github.com/modern-dev/gtl
+// Use of this source code is governed by the MIT
+// license that can be found in the LICENSE file.
+
+package graph
+
+import (
+	"fmt"
+	"math/rand"
+	"testing"
+)
+
+// roads returns a directed graph where the direct edges 0->1 and 2->3 are longer than the detour 0->2->1->3,
+// and vertex 4 is isolated.
+func roads() *Graph[int] {
+	g := NewDirected[int](5)
+	g.AddEdge(0, 1, 4)
+	g.AddEdge(0, 2, 1)
+	g.AddEdge(2, 1, 2)
+	g.AddEdge(1, 3, 1)
+	g.AddEdge(2, 3, 8)
+
+	return g
+}
+
+func TestDijkstra(t *testing.T) {
+	sp, err := Dijkstra(roads(), 0)
+
+	if err != nil {
+		t.Fatalf("Expected no error, got %v", err)
+	}
+
+	assertShortestPaths(sp, "[0 3 1 4 0]", "[0 2 1 3]", t)
+
+	if sp.Reachable(4) || sp.PathTo(4) != nil {
+		t.Errorf("Expected vertex 4 to be unreachable")
+	}
+
+	g := roads()
+	g.AddEdge(3, 4, -1)
+
+	if _, err := Dijkstra(g, 0); err != ErrNegativeWeight {
+		t.Errorf("Expected to get %v, got %v", ErrNegativeWeight, err)
+	}
+}
+
+func TestBellmanFord(t *testing.T) {
+	g := roads()
+	g.AddEdge(3, 4, -1)
+	g.AddEdge(4, 2, -2)
+
+	sp, err := BellmanFord(g, 0)
+
+	if err != nil {
+		t.Fatalf("Expected no error, got %v", err)
+	}
+
+	assertShortestPaths(sp, "[0 3 1 4 3]", "[0 2 1 3]", t)
+
+	g.AddEdge(4, 1, -5)
+
+	if _, err := BellmanFord(g, 0); err != ErrNegativeCycle {
+		t.Errorf("Expected to get %v, got %v", ErrNegativeCycle, err)
+	}
+}
+
+func TestAStar(t *testing.T) {
+	const side = 20
+
+	// a grid with random obstacles, the Manhattan distance is a consistent heuristic
+	rnd := rand.New(rand.NewSource(1))
+	g := NewUndirected[int](side * side)
+
+	for r := 0; r < side; r++ {
+		for c := 0; c < side; c++ {
+			if c+1 < side && rnd.Intn(5) > 0 {
+				g.AddEdge(r*side+c, r*side+c+1, 1)
+			}
+
+			if r+1 < side && rnd.Intn(5) > 0 {
+				g.AddEdge(r*side+c, (r+1)*side+c, 1)
+			}
+		}
+	}
+
+	target := side*side - 1
+	manhattan := func(v int) int {
+		return (side - 1 - v/side) + (side - 1 - v%side)
+	}
+
+	sp, _ := Dijkstra(g, 0)
+	path, dist, ok := AStar(g, 0, target, manhattan)
+
+	if ok != sp.Reachable(target) || dist != sp.Dist[target] || len(path) != len(sp.PathTo(target)) {
+		t.Errorf("Expected to get (%d, %t), got (%d, %t)", sp.Dist[target], sp.Reachable(target), dist, ok)
+	}
+
+	if _, _, ok := AStar(roads(), 0, 4, func(int) int { return 0 }); ok {
+		t.Errorf("Expected vertex 4 to be unreachable")
+	}
+}
+
+func assertShortestPaths[W Weight](sp *ShortestPaths[W], dist, pathTo3 string, t *testing.T) {
+	t.Helper()
+
+	if got := fmt.Sprint(sp.Dist); got != dist {
+		t.Errorf("Expected distances %s, got %s", dist, got)
+	}
+
+	if got := fmt.Sprint(sp.PathTo(3)); got != pathTo3 {
+		t.Errorf("Expected path %s, got %s", pathTo3, got)
+	}
+}
@@ -0,0 +1,121 @@
+// Copyright 2021. The GTL Authors. All rights reserved.
+// https://github.com/modern-dev/gtl
+// Use of this source code is governed by the MIT
+// license that can be found in the LICENSE file.
+
+package graph
+
+import (
+	"github.com/modern-dev/gtl/containers/deque"
+	"github.com/modern-dev/gtl/containers/queue"
+	"github.com/modern-dev/gtl/containers/stack"
+)
+
+// BFS visits the vertices reachable from the source in breadth-first order
+// with the number of edges on the shortest path from the source, until visit returns false.
+// Complexity - O(V + E).
+func BFS[W Weight](g *Graph[W], source int, visit func(v, depth int) bool) {
+	g.mustHaveVertex(source)
+
+	var q queue.Queue[int]
+
+	depth := make([]int, g.Order())
+
+	for v := range depth {
+		depth[v] = -1
+	}
+
+	depth[source] = 0
+	q.Push(source)
+
+	for !q.Empty() {
+		v := q.Pop()
+
+		if !visit(v, depth[v]) {
+			return
+		}
+
+		for _, e := range g.adj[v] {
+			if depth[e.To] < 0 {
+				depth[e.To] = depth[v] + 1
+				q.Push(e.To)
+			}
+		}
+	}
+}
+
+// DFS visits the vertices reachable from the source in depth-first preorder until visit returns false.
+// Neighbors are explored in the order their edges were added.
+// Complexity - O(V + E).
+func DFS[W Weight](g *Graph[W], source int, visit func(v int) bool) {
+	g.mustHaveVertex(source)
+
+	visited := make([]bool, g.Order())
+	s := stack.NewStack[int]()
+	s.Push(source)
+
+	for !s.Empty() {
+		v := s.Pop()
+
+		if visited[v] {
+			continue
+		}
+
+		visited[v] = true
+
+		if !visit(v) {
+			return
+		}
+
+		// push in reverse, so that the first neighbor is explored first
+		for i := len(g.adj[v]) - 1; i >= 0; i-- {
+			if to := g.adj[v][i].To; !visited[to] {
+				s.Push(to)
+			}
+		}
+	}
+}
+
+// TopologicalSort returns the vertices of a directed acyclic graph ordered so that every edge goes forward.
+// Returns ErrCycle if the graph has a cycle.
+// Complexity - O(V + E).
+func TopologicalSort[W Weight](g *Graph[W]) ([]int, error) {
+	if !g.directed {
+		return nil, ErrNotDirected
+	}
+
+	inDegree := make([]int, g.Order())
+
+	for v := range g.adj {
+		for _, e := range g.adj[v] {
+			inDegree[e.To]++
+		}
+	}
+
+	ready := deque.NewDeque[int]()
+
+	for v, d := range inDegree {
+		if d == 0 {
+			ready.PushBack(v)
+		}
+	}
+
+	order := make([]int, 0, g.Order())
+
+	for !ready.Empty() {
+		v := ready.PopFront()
+		order = append(order, v)
+
+		for _, e := range g.adj[v] {
+			if inDegree[e.To]--; inDegree[e.To] == 0 {
+				ready.PushBack(e.To)
+			}
+		}
+	}
+
+	if len(order) != g.Order() {
+		return nil, ErrCycle
+	}
+
+	return order, nil
+}
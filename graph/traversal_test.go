@@ -0,0 +1,94 @@
+// Copyright 2021. The GTL Authors. All rights reserved.
+// https://github.com/modern-dev/gtl
+// Use of this source code is governed by the MIT
+// license that can be found in the LICENSE file.
+
+package graph
+
+import (
+	"fmt"
+	"testing"
+)
+
+// tree returns the undirected graph
+//
+//	  0
+//	 / \
+//	1   2
+//	|   | \
+//	3   4  5
+func tree() *Graph[int] {
+	g := NewUndirected[int](6)
+	g.AddEdge(0, 1, 1)
+	g.AddEdge(0, 2, 1)
+	g.AddEdge(1, 3, 1)
+	g.AddEdge(2, 4, 1)
+	g.AddEdge(2, 5, 1)
+
+	return g
+}
+
+func TestBFS(t *testing.T) {
+	var visited []string
+
+	BFS(tree(), 0, func(v, depth int) bool {
+		visited = append(visited, fmt.Sprintf("%d:%d", v, depth))
+
+		return true
+	})
+
+	if got := fmt.Sprint(visited); got != "[0:0 1:1 2:1 3:2 4:2 5:2]" {
+		t.Errorf("Expected to visit %s, got %s", "[0:0 1:1 2:1 3:2 4:2 5:2]", got)
+	}
+
+	visited = visited[:0]
+
+	BFS(tree(), 4, func(v, depth int) bool {
+		visited = append(visited, fmt.Sprint(v))
+
+		return depth < 1
+	})
+
+	if got := fmt.Sprint(visited); got != "[4 2]" {
+		t.Errorf("Expected to visit %s, got %s", "[4 2]", got)
+	}
+}
+
+func TestDFS(t *testing.T) {
+	var visited []int
+
+	DFS(tree(), 0, func(v int) bool {
+		visited = append(visited, v)
+
+		return v != 4
+	})
+
+	if got := fmt.Sprint(visited); got != "[0 1 3 2 4]" {
+		t.Errorf("Expected to visit %s, got %s", "[0 1 3 2 4]", got)
+	}
+}
+
+func TestTopologicalSort(t *testing.T) {
+	g := NewDirected[int](5)
+	g.AddEdge(3, 1, 0)
+	g.AddEdge(1, 0, 0)
+	g.AddEdge(3, 2, 0)
+	g.AddEdge(2, 0, 0)
+	g.AddEdge(4, 2, 0)
+
+	order, err := TopologicalSort(g)
+
+	if err != nil || fmt.Sprint(order) != "[3 4 1 2 0]" {
+		t.Errorf("Expected to get ([3 4 1 2 0], nil), got (%v, %v)", order, err)
+	}
+
+	g.AddEdge(0, 4, 0)
+
+	if _, err := TopologicalSort(g); err != ErrCycle {
+		t.Errorf("Expected to get %v, got %v", ErrCycle, err)
+	}
+
+	if _, err := TopologicalSort(tree()); err != ErrNotDirected {
+		t.Errorf("Expected to get %v, got %v", ErrNotDirected, err)
+	}
+}
@@ -0,0 +1,57 @@
+// Copyright 2021. The GTL Authors. All rights reserved.
+// https://github.com/modern-dev/gtl
+// Use of this source code is governed by the MIT
+// license that can be found in the LICENSE file.
+
+// Package hashing implements the hash primitives shared by the GTL containers.
+package hashing
+
+const (
+	// FNVOffset is the initial state of FNV-1a, see FNVAdd.
+	FNVOffset uint64 = 0xcbf29ce484222325
+	fnvPrime  uint64 = 0x100000001b3
+
+	// Golden is 2^64 divided by the golden ratio, the increment of the splitmix64 sequence.
+	// The values Mix(i * Golden) for consecutive i are distinct and uniformly spread.
+	Golden uint64 = 0x9e3779b97f4a7c15
+)
+
+// FNVAdd adds the value, usually a byte or a rune, to the FNV-1a state.
+func FNVAdd(hash uint64, value uint64) uint64 {
+	return (hash ^ value) * fnvPrime
+}
+
+// String hashes the string with FNV-1a.
+func String(s string) uint64 {
+	hash := FNVOffset
+
+	for i := 0; i < len(s); i++ {
+		hash = FNVAdd(hash, uint64(s[i]))
+	}
+
+	return hash
+}
+
+// Bytes hashes the byte slice with FNV-1a.
+func Bytes(b []byte) uint64 {
+	hash := FNVOffset
+
+	for _, c := range b {
+		hash = FNVAdd(hash, uint64(c))
+	}
+
+	return hash
+}
+
+// Mix scrambles the bits of a hash, so that every bit of the result depends on all bits of the input.
+// It is the finalizer of splitmix64 and a bijection, so distinct hashes stay distinct.
+// The containers apply it to user provided hashes, which may be as poor as the identity of integers.
+func Mix(hash uint64) uint64 {
+	hash ^= hash >> 30
+	hash *= 0xbf58476d1ce4e5b9
+	hash ^= hash >> 27
+	hash *= 0x94d049bb133111eb
+	hash ^= hash >> 31
+
+	return hash
+}
@@ -0,0 +1,55 @@
+// Copyright 2021. The GTL Authors. All rights reserved.
+// https://github.com/modern-dev/gtl
+// Use of this source code is governed by the MIT
+// license that can be found in the LICENSE file.
+
+package hashing
+
+import (
+	"testing"
+)
+
+func TestFNV(t *testing.T) {
+	// the reference values of FNV-1a
+	for s, want := range map[string]uint64{
+		"":       0xcbf29ce484222325,
+		"a":      0xaf63dc4c8601ec8c,
+		"foobar": 0x85944171f73967e8,
+	} {
+		if got := String(s); got != want {
+			t.Errorf("Expected String(%q) to be %#x, got %#x", s, want, got)
+		}
+
+		if got := Bytes([]byte(s)); got != want {
+			t.Errorf("Expected Bytes(%q) to be %#x, got %#x", s, want, got)
+		}
+	}
+}
+
+func TestMix(t *testing.T) {
+	seen := map[uint64]bool{}
+
+	for i := uint64(0); i < 1000; i++ {
+		h := Mix(i * Golden)
+
+		if seen[h] {
+			t.Fatalf("Expected the mixed hashes to be distinct")
+		}
+
+		seen[h] = true
+	}
+
+	// a single flipped bit of the input changes about half of the bits of the result
+	for bit := 0; bit < 64; bit++ {
+		diff := Mix(42) ^ Mix(42^1<<bit)
+		ones := 0
+
+		for ; diff != 0; diff &= diff - 1 {
+			ones++
+		}
+
+		if ones < 16 || ones > 48 {
+			t.Errorf("Expected flipping bit %d to change about 32 bits, got %d", bit, ones)
+		}
+	}
+}
@@ -0,0 +1,49 @@
+// Copyright 2021. The GTL Authors. All rights reserved.
+// https://github.com/modern-dev/gtl
+// Use of this source code is governed by the MIT
+// license that can be found in the LICENSE file.
+
+// Package serial implements the versioned binary envelope shared by the GTL containers.
+// Every payload is prefixed with a single format version byte followed by its gob encoding.
+package serial
+
+import (
+	"bytes"
+	"encoding/gob"
+	"errors"
+	"fmt"
+)
+
+// ErrTruncated is returned when the data is too short to hold the version header.
+var ErrTruncated = errors.New("serial: data is truncated")
+
+// Marshal encodes payload prefixed with the given format version.
+func Marshal[T any](version byte, payload T) ([]byte, error) {
+	var buf bytes.Buffer
+
+	buf.WriteByte(version)
+
+	if err := gob.NewEncoder(&buf).Encode(payload); err != nil {
+		return nil, err
+	}
+
+	return buf.Bytes(), nil
+}
+
+// Unmarshal decodes a payload produced by Marshal.
+// An error is returned if the format version of data differs from the given one.
+func Unmarshal[T any](data []byte, version byte) (T, error) {
+	var payload T
+
+	if len(data) == 0 {
+		return payload, ErrTruncated
+	}
+
+	if data[0] != version {
+		return payload, fmt.Errorf("serial: unsupported format version %d, expected %d", data[0], version)
+	}
+
+	err := gob.NewDecoder(bytes.NewReader(data[1:])).Decode(&payload)
+
+	return payload, err
+}
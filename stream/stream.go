@@ -0,0 +1,180 @@
+// Copyright 2021. The GTL Authors. All rights reserved.
+// https://github.com/modern-dev/gtl
+// Use of this source code is governed by the MIT
+// license that can be found in the LICENSE file.
+
+// Package stream provides lazy pipelines over the funcs.Seq push iterators of the containers.
+//
+// A push iterator cannot be paused, so two Streams cannot be advanced in step and Zip takes its second sequence
+// as a pull function instead. To zip two Streams, collect the finite one first:
+//
+//	Zip(lhs, funcs.PullValues(rhs.Collect()))
+package stream
+
+import (
+	"github.com/modern-dev/gtl/funcs"
+)
+
+// Stream is a lazy pipeline over a funcs.Seq.
+// Intermediate operations only wrap the iterator, nothing is computed until a terminal operation runs it,
+// and the source is iterated no further than the pipeline needs, so Take stops a long or endless source early.
+// The Each methods of the containers may be converted directly, e.g. Stream[int](tree.Each).
+type Stream[T any] funcs.Seq[T]
+
+// Of creates a Stream over the sequence.
+func Of[T any](seq funcs.Seq[T]) Stream[T] {
+	return Stream[T](seq)
+}
+
+// FromSlice creates a Stream over the elements of the slice.
+func FromSlice[T any](s []T) Stream[T] {
+	return Stream[T](funcs.Values(s))
+}
+
+// Generate creates an endless Stream of the results of fn.
+func Generate[T any](fn func() T) Stream[T] {
+	return func(yield func(T) bool) {
+		for yield(fn()) {
+		}
+	}
+}
+
+// Seq returns the stream as a funcs.Seq, so it may be passed to the funcs helpers.
+func (s Stream[T]) Seq() funcs.Seq[T] {
+	return funcs.Seq[T](s)
+}
+
+// Filter returns the Stream of the elements that satisfy pred.
+func (s Stream[T]) Filter(pred func(T) bool) Stream[T] {
+	return Stream[T](funcs.FilterSeq(s.Seq(), pred))
+}
+
+// Take returns the Stream of at most n first elements.
+// The source is not asked for more than n elements.
+func (s Stream[T]) Take(n int) Stream[T] {
+	return func(yield func(T) bool) {
+		if n <= 0 {
+			return
+		}
+
+		taken := 0
+
+		s(func(el T) bool {
+			taken++
+
+			return yield(el) && taken < n
+		})
+	}
+}
+
+// Skip returns the Stream without n first elements.
+func (s Stream[T]) Skip(n int) Stream[T] {
+	return func(yield func(T) bool) {
+		skipped := 0
+
+		s(func(el T) bool {
+			if skipped < n {
+				skipped++
+
+				return true
+			}
+
+			return yield(el)
+		})
+	}
+}
+
+// TakeWhile returns the Stream of the leading elements that satisfy pred.
+// It stops at the first element that does not.
+func (s Stream[T]) TakeWhile(pred func(T) bool) Stream[T] {
+	return func(yield func(T) bool) {
+		s(func(el T) bool {
+			return pred(el) && yield(el)
+		})
+	}
+}
+
+// SkipWhile returns the Stream without the leading elements that satisfy pred.
+func (s Stream[T]) SkipWhile(pred func(T) bool) Stream[T] {
+	return func(yield func(T) bool) {
+		skipping := true
+
+		s(func(el T) bool {
+			if skipping = skipping && pred(el); skipping {
+				return true
+			}
+
+			return yield(el)
+		})
+	}
+}
+
+// Chain returns the Stream of the elements of s followed by the elements of the others.
+func (s Stream[T]) Chain(others ...Stream[T]) Stream[T] {
+	return func(yield func(T) bool) {
+		stopped := false
+		proxy := func(el T) bool {
+			stopped = !yield(el)
+
+			return !stopped
+		}
+
+		s(proxy)
+
+		for _, other := range others {
+			if stopped {
+				return
+			}
+
+			other(proxy)
+		}
+	}
+}
+
+// Each calls fn for every element until fn returns false.
+func (s Stream[T]) Each(fn func(T) bool) {
+	s(fn)
+}
+
+// Collect returns the slice of all elements.
+func (s Stream[T]) Collect() []T {
+	return funcs.Collect(s.Seq())
+}
+
+// Into adds all elements to a container with the given method, e.g. s.Into(vec.PushBack) or s.Into(tree.Insert).
+func (s Stream[T]) Into(add func(T)) {
+	s(func(el T) bool {
+		add(el)
+
+		return true
+	})
+}
+
+// Count returns the number of elements.
+func (s Stream[T]) Count() int {
+	n := 0
+
+	s(func(T) bool {
+		n++
+
+		return true
+	})
+
+	return n
+}
+
+// First returns the first element. Returns false if the stream is empty.
+func (s Stream[T]) First() (T, bool) {
+	var (
+		first T
+		found bool
+	)
+
+	s(func(el T) bool {
+		first, found = el, true
+
+		return false
+	})
+
+	return first, found
+}
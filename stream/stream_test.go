@@ -0,0 +1,105 @@
+// Copyright 2021. The GTL Authors. All rights reserved.
+// https://github.com/modern-dev/gtl
+// Use of this source code is governed by the MIT
+// license that can be found in the LICENSE file.
+
+package stream
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/modern-dev/gtl/containers/rbtree"
+	"github.com/modern-dev/gtl/containers/unordered_set"
+	"github.com/modern-dev/gtl/containers/vector"
+	"github.com/modern-dev/gtl/funcs"
+)
+
+func TestStreamShortCircuit(t *testing.T) {
+	tree := rbtree.NewRBTree[int](false)
+
+	for i := 0; i < 1000; i++ {
+		tree.Insert(i)
+	}
+
+	visited := 0
+	rangeFrom100 := Stream[int](func(yield func(int) bool) {
+		tree.Range(100, 1000, yield)
+	})
+
+	firstOdd := rangeFrom100.
+		Filter(func(n int) bool {
+			visited++
+
+			return n%2 == 1
+		}).
+		Take(10).
+		Collect()
+
+	assertStream(FromSlice(firstOdd), "[101 103 105 107 109 111 113 115 117 119]", t)
+
+	if visited != 20 {
+		t.Errorf("Expected the filter to see %d elements, got %d", 20, visited)
+	}
+}
+
+func TestStreamOperations(t *testing.T) {
+	nums := FromSlice([]int{1, 2, 3, 4, 5, 6, 7})
+	less := func(n int) func(int) bool {
+		return func(el int) bool { return el < n }
+	}
+
+	assertStream(nums.Skip(2).Take(3), "[3 4 5]", t)
+	assertStream(nums.Take(0), "[]", t)
+	assertStream(nums.Skip(10), "[]", t)
+	assertStream(nums.TakeWhile(less(4)), "[1 2 3]", t)
+	assertStream(nums.SkipWhile(less(4)), "[4 5 6 7]", t)
+	assertStream(nums.Take(2).Chain(nums.Skip(6), FromSlice([]int{0})), "[1 2 7 0]", t)
+	assertStream(nums.Chain(nums).Take(9), "[1 2 3 4 5 6 7 1 2]", t)
+
+	if n := nums.Filter(less(3)).Count(); n != 2 {
+		t.Errorf("Expected %d elements, got %d", 2, n)
+	}
+
+	if el, ok := nums.Skip(3).First(); !ok || el != 4 {
+		t.Errorf("Expected to get (%d, %t), got (%d, %t)", 4, true, el, ok)
+	}
+
+	if _, ok := nums.Skip(7).First(); ok {
+		t.Errorf("Expected First() of an empty stream to fail")
+	}
+
+	counter := 0
+	naturals := Generate(func() int { counter++; return counter })
+
+	if sum := funcs.SumBySeq(naturals.Take(100).Seq(), func(n int) int { return n }); sum != 5050 {
+		t.Errorf("Expected sum %d, got %d", 5050, sum)
+	}
+}
+
+func TestStreamInto(t *testing.T) {
+	words := FromSlice([]string{"b", "a", "c", "a"})
+
+	vec := vector.NewVector[string]()
+	words.Into(vec.PushBack)
+
+	tree := rbtree.NewRBTree[string](true)
+	words.Into(tree.Insert)
+
+	set := unordered_set.NewUnorderedSet[string]()
+	words.Into(set.Insert)
+
+	if vec.Size() != 4 || tree.Size() != 4 || set.Size() != 3 {
+		t.Errorf("Expected sizes [4 4 3], got [%d %d %d]", vec.Size(), tree.Size(), set.Size())
+	}
+
+	assertStream(Stream[string](tree.Each), "[a a b c]", t)
+}
+
+func assertStream[T any](s Stream[T], expected string, t *testing.T) {
+	t.Helper()
+
+	if got := fmt.Sprint(s.Collect()); got != expected {
+		t.Errorf("Expected to get %s, got %s", expected, got)
+	}
+}
@@ -0,0 +1,69 @@
+// Copyright 2021. The GTL Authors. All rights reserved.
+// https://github.com/modern-dev/gtl
+// Use of this source code is governed by the MIT
+// license that can be found in the LICENSE file.
+
+package stream
+
+import (
+	"github.com/modern-dev/gtl/funcs"
+	"github.com/modern-dev/gtl/utility"
+)
+
+// The operations changing the type of the elements are functions, as methods cannot have type parameters.
+
+// Map returns the Stream of results of fn applied to every element.
+func Map[T any, R any](s Stream[T], fn func(T) R) Stream[R] {
+	return Stream[R](funcs.MapSeq(s.Seq(), fn))
+}
+
+// Zip returns the Stream of pairs of elements of lhs and the elements returned by next at the same positions.
+// It ends with the shorter of the sequences.
+// The second sequence is a pull iterator, e.g. funcs.PullValues, so both sides advance in step
+// on the goroutine running the pipeline: next is called once per element of lhs and never after the pipeline stops.
+//
+// Two Streams cannot be zipped directly, as a push iterator cannot be paused without a goroutine.
+// Collect one of them instead, e.g. Zip(lhs, funcs.PullValues(rhs.Collect())), which requires it to be finite.
+func Zip[T1 any, T2 any](lhs Stream[T1], next func() (T2, bool)) Stream[utility.Pair[T1, T2]] {
+	return Stream[utility.Pair[T1, T2]](funcs.ZipSeq(lhs.Seq(), next))
+}
+
+// Window returns the Stream of sliding windows of the given size moving by one element.
+// Every window is a fresh slice. A stream shorter than size produces no windows. Panics if size is not positive.
+func Window[T any](s Stream[T], size int) Stream[[]T] {
+	mustBePositive(size)
+
+	return func(yield func([]T) bool) {
+		ring := make([]T, 0, size)
+
+		s(func(el T) bool {
+			if len(ring) == size {
+				copy(ring, ring[1:])
+				ring = ring[:size-1]
+			}
+
+			if ring = append(ring, el); len(ring) < size {
+				return true
+			}
+
+			window := make([]T, size)
+			copy(window, ring)
+
+			return yield(window)
+		})
+	}
+}
+
+// Batch returns the Stream of consecutive batches of the given size, the last batch may be shorter.
+// Every batch is a fresh slice. Panics if size is not positive.
+func Batch[T any](s Stream[T], size int) Stream[[]T] {
+	mustBePositive(size)
+
+	return Stream[[]T](funcs.ChunkSeq(s.Seq(), size))
+}
+
+func mustBePositive(size int) {
+	if size <= 0 {
+		panic("stream: size must be positive")
+	}
+}
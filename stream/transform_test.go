@@ -0,0 +1,87 @@
+// Copyright 2021. The GTL Authors. All rights reserved.
+// https://github.com/modern-dev/gtl
+// Use of this source code is governed by the MIT
+// license that can be found in the LICENSE file.
+
+package stream
+
+import (
+	"strconv"
+	"testing"
+
+	"github.com/modern-dev/gtl/containers/rbtree"
+	"github.com/modern-dev/gtl/funcs"
+)
+
+func TestMap(t *testing.T) {
+	labels := Map(FromSlice([]int{1, 2, 3}), func(n int) string { return "#" + strconv.Itoa(n) })
+
+	assertStream(labels, "[#1 #2 #3]", t)
+}
+
+func TestZip(t *testing.T) {
+	names := FromSlice([]string{"a", "b", "c"})
+	counter := 0
+	naturals := func() (int, bool) { counter++; return counter, true }
+
+	assertStream(Zip(names, naturals), "[(a, 1) (b, 2) (c, 3)]", t)
+	assertStream(Zip(FromSlice([]int{1, 2, 3}), funcs.PullValues([]string{"a"})), "[(1, a)]", t)
+	assertStream(Zip(names, funcs.PullValues([]int{})), "[]", t)
+}
+
+func TestZipStreams(t *testing.T) {
+	tree := rbtree.NewRBTree[int](false)
+
+	for _, n := range []int{8, 3, 5, 1, 9, 2} {
+		tree.Insert(n)
+	}
+
+	rangeOf := func(from, to int) Stream[int] {
+		return func(yield func(int) bool) { tree.Range(from, to, yield) }
+	}
+
+	assertStream(Zip(rangeOf(1, 4), funcs.PullValues(rangeOf(5, 10).Collect())), "[(1, 5) (2, 8) (3, 9)]", t)
+}
+
+func TestZipEarlyStop(t *testing.T) {
+	counter := 0
+	naturals := func() (int, bool) { counter++; return counter, true }
+
+	assertStream(Zip(FromSlice([]string{"a", "b", "c"}), naturals).Take(2), "[(a, 1) (b, 2)]", t)
+
+	// the right-hand side is not read past the last consumed pair
+	if counter != 2 {
+		t.Errorf("Expected to get %d, got %d", 2, counter)
+	}
+}
+
+func TestZipPanickingSource(t *testing.T) {
+	failing := func() (int, bool) { panic("source failed") }
+
+	defer func() {
+		if r := recover(); r != "source failed" {
+			t.Errorf("Expected the panic to reach the caller, got %v", r)
+		}
+	}()
+
+	Zip(FromSlice([]int{1}), failing).Collect()
+}
+
+func TestWindowBatch(t *testing.T) {
+	nums := FromSlice([]int{1, 2, 3, 4, 5})
+
+	assertStream(Window(nums, 3), "[[1 2 3] [2 3 4] [3 4 5]]", t)
+	assertStream(Window(nums, 6), "[]", t)
+	assertStream(Window(nums, 1).Take(2), "[[1] [2]]", t)
+	assertStream(Batch(nums, 2), "[[1 2] [3 4] [5]]", t)
+	assertStream(Batch(nums, 5), "[[1 2 3 4 5]]", t)
+	assertStream(Batch(nums, 2).Take(1), "[[1 2]]", t)
+
+	// the windows must not share memory
+	windows := Window(nums, 2).Collect()
+	windows[0][1] = 42
+
+	if windows[1][0] != 2 {
+		t.Errorf("Expected windows to be independent, got %v", windows)
+	}
+}
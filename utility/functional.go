@@ -13,6 +13,10 @@ type (
 	}
 	Less[T constraints.Ordered]    struct{}
 	Greater[T constraints.Ordered] struct{}
+
+	// CompareFunc is an adapter to allow the use of ordinary functions as Compare.
+	// If f is a function with the appropriate signature, CompareFunc[T](f) is a Compare that calls f.
+	CompareFunc[T any] func(lhs T, rhs T) bool
 )
 
 func (l *Less[T]) Cmp(lhs T, rhs T) bool {
@@ -22,3 +26,8 @@ func (l *Less[T]) Cmp(lhs T, rhs T) bool {
 func (g *Greater[T]) Cmp(lhs T, rhs T) bool {
 	return lhs > rhs
 }
+
+// Cmp calls f(lhs, rhs).
+func (f CompareFunc[T]) Cmp(lhs T, rhs T) bool {
+	return f(lhs, rhs)
+}
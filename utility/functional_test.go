@@ -22,3 +22,17 @@ func TestGreater(t *testing.T) {
 		t.Errorf("expected 2 to be greater than 1, got false instead")
 	}
 }
+
+func TestCompareFunc(t *testing.T) {
+	var byLen Compare[string] = CompareFunc[string](func(lhs, rhs string) bool {
+		return len(lhs) < len(rhs)
+	})
+
+	if byLen.Cmp("abc", "de") != false {
+		t.Errorf("expected \"abc\" to not be less than \"de\", got true instead")
+	}
+
+	if byLen.Cmp("de", "abc") != true {
+		t.Errorf("expected \"de\" to be less than \"abc\", got false instead")
+	}
+}
@@ -0,0 +1,119 @@
+// Copyright 2021. The GTL Authors. All rights reserved.
+// https://github.com/modern-dev/gtl
+// Use of this source code is governed by the MIT
+// license that can be found in the LICENSE file.
+
+package utility
+
+import (
+	"constraints"
+	"unicode"
+	"unicode/utf8"
+
+	"github.com/modern-dev/gtl/internal/hashing"
+)
+
+type (
+	// Hasher defines the identity of elements for hashed containers.
+	// Equal elements must have equal hashes, the opposite is not required.
+	Hasher[T any] interface {
+		Hash(value T) uint64
+		Equal(lhs, rhs T) bool
+	}
+
+	hasherFuncs[T any] struct {
+		hash  func(T) uint64
+		equal func(lhs, rhs T) bool
+	}
+)
+
+func (h hasherFuncs[T]) Hash(value T) uint64 {
+	return h.hash(value)
+}
+
+func (h hasherFuncs[T]) Equal(lhs, rhs T) bool {
+	return h.equal(lhs, rhs)
+}
+
+// MakeHasher creates a Hasher from a pair of ordinary functions.
+func MakeHasher[T any](hash func(T) uint64, equal func(lhs, rhs T) bool) Hasher[T] {
+	return hasherFuncs[T]{hash, equal}
+}
+
+// IntegerHasher returns the Hasher of integers that uses their value as the hash.
+func IntegerHasher[T constraints.Integer]() Hasher[T] {
+	return MakeHasher(func(value T) uint64 {
+		return uint64(value)
+	}, func(lhs, rhs T) bool {
+		return lhs == rhs
+	})
+}
+
+// StringHasher returns the Hasher of strings based on FNV-1a.
+func StringHasher() Hasher[string] {
+	return MakeHasher(hashing.String, func(lhs, rhs string) bool {
+		return lhs == rhs
+	})
+}
+
+// BytesHasher returns the Hasher of byte slices based on FNV-1a.
+// Slices with the same contents are equal, nil is equal to an empty slice.
+func BytesHasher() Hasher[[]byte] {
+	return MakeHasher(hashing.Bytes, func(lhs, rhs []byte) bool {
+		return string(lhs) == string(rhs)
+	})
+}
+
+// CaseInsensitiveHasher returns the Hasher of strings that ignores Unicode letter case.
+// It is consistent with CaseInsensitive: strings are equal if the Ordering returns zero for them.
+func CaseInsensitiveHasher() Hasher[string] {
+	ordering := CaseInsensitive()
+
+	return MakeHasher(func(value string) uint64 {
+		hash := hashing.FNVOffset
+
+		for value != "" {
+			r, size := utf8.DecodeRuneInString(value)
+			hash = hashing.FNVAdd(hash, uint64(unicode.ToLower(r)))
+			value = value[size:]
+		}
+
+		return hash
+	}, func(lhs, rhs string) bool {
+		return ordering(lhs, rhs) == 0
+	})
+}
+
+// SliceHasher returns the Hasher of slices that compares them element by element with the given Hasher.
+func SliceHasher[T any](elem Hasher[T]) Hasher[[]T] {
+	return MakeHasher(func(value []T) uint64 {
+		hash := uint64(len(value))
+
+		for _, el := range value {
+			hash = HashCombine(hash, elem.Hash(el))
+		}
+
+		return hash
+	}, func(lhs, rhs []T) bool {
+		if len(lhs) != len(rhs) {
+			return false
+		}
+
+		for i := range lhs {
+			if !elem.Equal(lhs[i], rhs[i]) {
+				return false
+			}
+		}
+
+		return true
+	})
+}
+
+// PairHasher returns the Hasher of pairs that combines the given Hashers of the elements.
+func PairHasher[T1 any, T2 any](first Hasher[T1], second Hasher[T2]) Hasher[Pair[T1, T2]] {
+	return MakeHasher(func(value Pair[T1, T2]) uint64 {
+		return HashPair(value, first.Hash, second.Hash)
+	}, func(lhs, rhs Pair[T1, T2]) bool {
+		return first.Equal(lhs.First, rhs.First) && second.Equal(lhs.Second, rhs.Second)
+	})
+}
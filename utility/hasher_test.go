@@ -0,0 +1,43 @@
+// Copyright 2021. The GTL Authors. All rights reserved.
+// https://github.com/modern-dev/gtl
+// Use of this source code is governed by the MIT
+// license that can be found in the LICENSE file.
+
+package utility
+
+import "testing"
+
+func TestStringHashers(t *testing.T) {
+	assertHasher(StringHasher(), "Go", "Go", true, t)
+	assertHasher(StringHasher(), "Go", "go", false, t)
+	assertHasher(CaseInsensitiveHasher(), "Straße", "STRASSE", false, t)
+	assertHasher(CaseInsensitiveHasher(), "Привет", "пРИВЕТ", true, t)
+	assertHasher(CaseInsensitiveHasher(), "go", "gopher", false, t)
+	assertHasher(BytesHasher(), nil, []byte{}, true, t)
+	assertHasher(BytesHasher(), []byte("ab"), []byte("ba"), false, t)
+}
+
+func TestCompositeHashers(t *testing.T) {
+	words := SliceHasher(CaseInsensitiveHasher())
+
+	assertHasher(words, []string{"Hello", "World"}, []string{"hello", "WORLD"}, true, t)
+	assertHasher(words, []string{"Hello", "World"}, []string{"World", "Hello"}, false, t)
+	assertHasher(words, []string{"a"}, []string{"a", ""}, false, t)
+
+	pairs := PairHasher(IntegerHasher[int](), StringHasher())
+
+	assertHasher(pairs, *MakePair(1, "one"), *MakePair(1, "one"), true, t)
+	assertHasher(pairs, *MakePair(1, "one"), *MakePair(2, "one"), false, t)
+}
+
+func assertHasher[T any](h Hasher[T], lhs, rhs T, equal bool, t *testing.T) {
+	t.Helper()
+
+	if h.Equal(lhs, rhs) != equal || h.Equal(rhs, lhs) != equal {
+		t.Errorf("Expected Equal(%v, %v) to be %t", lhs, rhs, equal)
+	}
+
+	if equal && h.Hash(lhs) != h.Hash(rhs) {
+		t.Errorf("Expected equal elements %v and %v to have equal hashes", lhs, rhs)
+	}
+}
@@ -0,0 +1,116 @@
+// Copyright 2021. The GTL Authors. All rights reserved.
+// https://github.com/modern-dev/gtl
+// Use of this source code is governed by the MIT
+// license that can be found in the LICENSE file.
+
+package utility
+
+import (
+	"constraints"
+	"unicode"
+	"unicode/utf8"
+)
+
+// Ordering is a three-way comparator.
+// It returns a negative number if lhs is ordered before rhs, a positive number if lhs is ordered after rhs
+// and zero if both elements are equivalent.
+type Ordering[T any] func(lhs, rhs T) int
+
+type orderingCompare[T any] struct {
+	ordering Ordering[T]
+}
+
+func (o *orderingCompare[T]) Cmp(lhs T, rhs T) bool {
+	return o.ordering(lhs, rhs) < 0
+}
+
+// ToCompare adapts the Ordering to the Compare interface.
+func ToCompare[T any](ordering Ordering[T]) Compare[T] {
+	return &orderingCompare[T]{ordering}
+}
+
+// ToOrdering adapts the Compare to the Ordering.
+// The equivalence of two elements is inferred with two calls to Cmp, so prefer a native Ordering when possible.
+func ToOrdering[T any](cmp Compare[T]) Ordering[T] {
+	if oc, ok := cmp.(*orderingCompare[T]); ok {
+		return oc.ordering
+	}
+
+	return func(lhs, rhs T) int {
+		if cmp.Cmp(lhs, rhs) {
+			return -1
+		}
+
+		if cmp.Cmp(rhs, lhs) {
+			return 1
+		}
+
+		return 0
+	}
+}
+
+// Natural returns the Ordering of T defined by the < operator.
+func Natural[T constraints.Ordered]() Ordering[T] {
+	return func(lhs, rhs T) int {
+		switch {
+		case lhs < rhs:
+			return -1
+		case rhs < lhs:
+			return 1
+		default:
+			return 0
+		}
+	}
+}
+
+// By returns the Ordering of T by the natural ordering of the key extracted from every element.
+func By[T any, K constraints.Ordered](key func(T) K) Ordering[T] {
+	return ByKey(key, Natural[K]())
+}
+
+// ByKey returns the Ordering of T by the ordering of the key extracted from every element.
+func ByKey[T any, K any](key func(T) K, ordering Ordering[K]) Ordering[T] {
+	return func(lhs, rhs T) int {
+		return ordering(key(lhs), key(rhs))
+	}
+}
+
+// CaseInsensitive returns the Ordering of strings that ignores Unicode letter case.
+func CaseInsensitive() Ordering[string] {
+	return func(lhs, rhs string) int {
+		for lhs != "" && rhs != "" {
+			lr, ls := utf8.DecodeRuneInString(lhs)
+			rr, rs := utf8.DecodeRuneInString(rhs)
+
+			if lr, rr = unicode.ToLower(lr), unicode.ToLower(rr); lr != rr {
+				if lr < rr {
+					return -1
+				}
+
+				return 1
+			}
+
+			lhs, rhs = lhs[ls:], rhs[rs:]
+		}
+
+		return len(lhs) - len(rhs)
+	}
+}
+
+// Reverse returns the Ordering that orders elements in the opposite direction.
+func (o Ordering[T]) Reverse() Ordering[T] {
+	return func(lhs, rhs T) int {
+		return o(rhs, lhs)
+	}
+}
+
+// ThenBy returns the Ordering that falls back to next when elements are equivalent according to o.
+func (o Ordering[T]) ThenBy(next Ordering[T]) Ordering[T] {
+	return func(lhs, rhs T) int {
+		if res := o(lhs, rhs); res != 0 {
+			return res
+		}
+
+		return next(lhs, rhs)
+	}
+}
@@ -0,0 +1,95 @@
+// Copyright 2021. The GTL Authors. All rights reserved.
+// https://github.com/modern-dev/gtl
+// Use of this source code is governed by the MIT
+// license that can be found in the LICENSE file.
+
+package utility
+
+import "testing"
+
+type employee struct {
+	name string
+	age  int
+}
+
+func TestOrderingAdapters(t *testing.T) {
+	natural := Natural[int]()
+	fromCompare := ToOrdering[int](&Greater[int]{})
+	toCompare := ToCompare(natural)
+
+	cases := []struct{ lhs, rhs, natural int }{
+		{1, 2, -1},
+		{2, 1, 1},
+		{2, 2, 0},
+	}
+
+	for _, c := range cases {
+		if got := sign(natural(c.lhs, c.rhs)); got != c.natural {
+			t.Errorf("Natural()(%d, %d) = %d, want %d", c.lhs, c.rhs, got, c.natural)
+		}
+
+		if got := sign(fromCompare(c.lhs, c.rhs)); got != -c.natural {
+			t.Errorf("ToOrdering(Greater)(%d, %d) = %d, want %d", c.lhs, c.rhs, got, -c.natural)
+		}
+
+		if got := toCompare.Cmp(c.lhs, c.rhs); got != (c.natural < 0) {
+			t.Errorf("ToCompare(Natural()).Cmp(%d, %d) = %t, want %t", c.lhs, c.rhs, got, c.natural < 0)
+		}
+	}
+}
+
+func TestOrderingCombinators(t *testing.T) {
+	byAgeThenName := By(func(e employee) int { return e.age }).
+		ThenBy(ByKey(func(e employee) string { return e.name }, CaseInsensitive()))
+
+	cases := []struct {
+		lhs, rhs employee
+		expected int
+	}{
+		{employee{"bob", 30}, employee{"alice", 40}, -1},
+		{employee{"bob", 30}, employee{"Alice", 30}, 1},
+		{employee{"Bob", 30}, employee{"bob", 30}, 0},
+	}
+
+	for _, c := range cases {
+		if got := sign(byAgeThenName(c.lhs, c.rhs)); got != c.expected {
+			t.Errorf("compare(%v, %v) = %d, want %d", c.lhs, c.rhs, got, c.expected)
+		}
+
+		if got := sign(byAgeThenName.Reverse()(c.lhs, c.rhs)); got != -c.expected {
+			t.Errorf("reversed compare(%v, %v) = %d, want %d", c.lhs, c.rhs, got, -c.expected)
+		}
+	}
+}
+
+func TestCaseInsensitive(t *testing.T) {
+	ci := CaseInsensitive()
+
+	cases := []struct {
+		lhs, rhs string
+		expected int
+	}{
+		{"Hello", "hello", 0},
+		{"ÄPFEL", "äpfel", 0},
+		{"apple", "Banana", -1},
+		{"abc", "AB", 1},
+		{"", "a", -1},
+	}
+
+	for _, c := range cases {
+		if got := sign(ci(c.lhs, c.rhs)); got != c.expected {
+			t.Errorf("CaseInsensitive()(%q, %q) = %d, want %d", c.lhs, c.rhs, got, c.expected)
+		}
+	}
+}
+
+func sign(x int) int {
+	switch {
+	case x < 0:
+		return -1
+	case x > 0:
+		return 1
+	default:
+		return 0
+	}
+}
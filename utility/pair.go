@@ -5,11 +5,91 @@
 
 package utility
 
-type Pair[T1 any, T2 any] struct {
-	First  T1
-	Second T2
-}
+import (
+	"constraints"
+	"fmt"
+)
+
+type (
+	// Pair stores two heterogeneous elements as a single unit.
+	// A Pair of comparable elements is comparable itself and may be used as a map key.
+	Pair[T1 any, T2 any] struct {
+		First  T1
+		Second T2
+	}
+
+	// PairLess orders pairs lexicographically using Less for every element.
+	PairLess[T1 constraints.Ordered, T2 constraints.Ordered] struct{}
+
+	// PairGreater orders pairs lexicographically using Greater for every element.
+	PairGreater[T1 constraints.Ordered, T2 constraints.Ordered] struct{}
+)
 
 func MakePair[T1 any, T2 any](first T1, second T2) *Pair[T1, T2] {
 	return &Pair[T1, T2]{first, second}
 }
+
+// Unpack returns the elements of the Pair.
+func (p Pair[T1, T2]) Unpack() (T1, T2) {
+	return p.First, p.Second
+}
+
+// Swap returns a new Pair with the elements of p in reversed order.
+func (p Pair[T1, T2]) Swap() *Pair[T2, T1] {
+	return MakePair(p.Second, p.First)
+}
+
+// String implements the fmt.Stringer interface.
+func (p Pair[T1, T2]) String() string {
+	return fmt.Sprintf("(%v, %v)", p.First, p.Second)
+}
+
+// PairEqual checks if both elements of the pairs are equal.
+func PairEqual[T1 comparable, T2 comparable](lhs, rhs *Pair[T1, T2]) bool {
+	return *lhs == *rhs
+}
+
+// HashPair combines the hashes of the Pair elements computed by the given hash functions.
+func HashPair[T1 any, T2 any](p Pair[T1, T2], hash1 func(T1) uint64, hash2 func(T2) uint64) uint64 {
+	return HashCombine(hash1(p.First), hash2(p.Second))
+}
+
+// LexicographicPair returns a Compare that orders pairs by the First elements
+// and then by the Second elements if the First ones are equivalent.
+func LexicographicPair[T1 any, T2 any](first Compare[T1], second Compare[T2]) Compare[Pair[T1, T2]] {
+	return CompareFunc[Pair[T1, T2]](func(lhs, rhs Pair[T1, T2]) bool {
+		if less, ok := lexicographicStep(first, lhs.First, rhs.First); ok {
+			return less
+		}
+
+		return second.Cmp(lhs.Second, rhs.Second)
+	})
+}
+
+func (*PairLess[T1, T2]) Cmp(lhs Pair[T1, T2], rhs Pair[T1, T2]) bool {
+	return LexicographicPair[T1, T2](&Less[T1]{}, &Less[T2]{}).Cmp(lhs, rhs)
+}
+
+func (*PairGreater[T1, T2]) Cmp(lhs Pair[T1, T2], rhs Pair[T1, T2]) bool {
+	return LexicographicPair[T1, T2](&Greater[T1]{}, &Greater[T2]{}).Cmp(lhs, rhs)
+}
+
+// HashCombine mixes hash into seed and returns the result.
+// It may be chained to hash composite values from the hashes of their parts.
+func HashCombine(seed, hash uint64) uint64 {
+	return seed ^ (hash + 0x9e3779b97f4a7c15 + (seed << 6) + (seed >> 2))
+}
+
+// lexicographicStep compares a single position of two sequences.
+// The second result is false if the elements are equivalent and the next position has to be compared.
+func lexicographicStep[T any](cmp Compare[T], lhs, rhs T) (bool, bool) {
+	if cmp.Cmp(lhs, rhs) {
+		return true, true
+	}
+
+	if cmp.Cmp(rhs, lhs) {
+		return false, true
+	}
+
+	return false, false
+}
@@ -5,7 +5,10 @@
 
 package utility
 
-import "testing"
+import (
+	"fmt"
+	"testing"
+)
 
 func TestMakePair(t *testing.T) {
 	p1 := MakePair[int, string](1488, "Deus vult")
@@ -15,3 +18,69 @@ func TestMakePair(t *testing.T) {
 		t.Errorf("expected {%d, %s}, got {%d, %s}", expectedFirst, expectedSecond, p1.First, p1.Second)
 	}
 }
+
+func TestPairSwapUnpack(t *testing.T) {
+	p := MakePair(42, "answer").Swap()
+	first, second := p.Unpack()
+
+	if first != "answer" || second != 42 {
+		t.Errorf("expected {answer, 42}, got {%s, %d}", first, second)
+	}
+
+	if s := fmt.Sprint(*p); s != "(answer, 42)" {
+		t.Errorf("expected (answer, 42), got %s", s)
+	}
+}
+
+func TestPairEqual(t *testing.T) {
+	if !PairEqual(MakePair(1, "a"), MakePair(1, "a")) {
+		t.Errorf("expected pairs to be equal, got false instead")
+	}
+
+	if PairEqual(MakePair(1, "a"), MakePair(1, "b")) {
+		t.Errorf("expected pairs to not be equal, got true instead")
+	}
+
+	seen := map[Pair[int, string]]bool{*MakePair(1, "a"): true}
+
+	if !seen[*MakePair(1, "a")] {
+		t.Errorf("expected pair to be usable as a map key")
+	}
+}
+
+func TestHashPair(t *testing.T) {
+	id := func(x int) uint64 { return uint64(x) }
+
+	if HashPair(*MakePair(1, 2), id, id) == HashPair(*MakePair(2, 1), id, id) {
+		t.Errorf("expected hash to depend on the order of elements")
+	}
+}
+
+func TestPairComparators(t *testing.T) {
+	cases := []struct {
+		lhs, rhs Pair[int, string]
+		less     bool
+		greater  bool
+	}{
+		{*MakePair(1, "b"), *MakePair(2, "a"), true, false},
+		{*MakePair(1, "a"), *MakePair(1, "b"), true, false},
+		{*MakePair(1, "b"), *MakePair(1, "a"), false, true},
+		{*MakePair(1, "a"), *MakePair(1, "a"), false, false},
+	}
+
+	for _, c := range cases {
+		if got := (&PairLess[int, string]{}).Cmp(c.lhs, c.rhs); got != c.less {
+			t.Errorf("PairLess.Cmp(%v, %v) = %t, want %t", c.lhs, c.rhs, got, c.less)
+		}
+
+		if got := (&PairGreater[int, string]{}).Cmp(c.lhs, c.rhs); got != c.greater {
+			t.Errorf("PairGreater.Cmp(%v, %v) = %t, want %t", c.lhs, c.rhs, got, c.greater)
+		}
+	}
+
+	mixed := LexicographicPair[int, string](&Less[int]{}, &Greater[string]{})
+
+	if !mixed.Cmp(*MakePair(1, "b"), *MakePair(1, "a")) {
+		t.Errorf("expected (1, b) to be ordered before (1, a), got false instead")
+	}
+}
@@ -0,0 +1,146 @@
+// Copyright 2021. The GTL Authors. All rights reserved.
+// https://github.com/modern-dev/gtl
+// Use of this source code is governed by the MIT
+// license that can be found in the LICENSE file.
+
+package utility
+
+import (
+	"constraints"
+	"fmt"
+)
+
+type (
+	// Tuple3 stores three heterogeneous elements as a single unit.
+	// A Tuple3 of comparable elements is comparable itself and may be used as a map key.
+	Tuple3[T1 any, T2 any, T3 any] struct {
+		First  T1
+		Second T2
+		Third  T3
+	}
+
+	// Tuple4 stores four heterogeneous elements as a single unit.
+	// A Tuple4 of comparable elements is comparable itself and may be used as a map key.
+	Tuple4[T1 any, T2 any, T3 any, T4 any] struct {
+		First  T1
+		Second T2
+		Third  T3
+		Fourth T4
+	}
+
+	// Tuple3Less orders tuples lexicographically using Less for every element.
+	Tuple3Less[T1 constraints.Ordered, T2 constraints.Ordered, T3 constraints.Ordered] struct{}
+
+	// Tuple3Greater orders tuples lexicographically using Greater for every element.
+	Tuple3Greater[T1 constraints.Ordered, T2 constraints.Ordered, T3 constraints.Ordered] struct{}
+
+	// Tuple4Less orders tuples lexicographically using Less for every element.
+	Tuple4Less[T1 constraints.Ordered, T2 constraints.Ordered, T3 constraints.Ordered, T4 constraints.Ordered] struct{}
+
+	// Tuple4Greater orders tuples lexicographically using Greater for every element.
+	Tuple4Greater[T1 constraints.Ordered, T2 constraints.Ordered, T3 constraints.Ordered, T4 constraints.Ordered] struct{}
+)
+
+func MakeTuple3[T1 any, T2 any, T3 any](first T1, second T2, third T3) *Tuple3[T1, T2, T3] {
+	return &Tuple3[T1, T2, T3]{first, second, third}
+}
+
+func MakeTuple4[T1 any, T2 any, T3 any, T4 any](first T1, second T2, third T3, fourth T4) *Tuple4[T1, T2, T3, T4] {
+	return &Tuple4[T1, T2, T3, T4]{first, second, third, fourth}
+}
+
+// Unpack returns the elements of the Tuple3.
+func (t Tuple3[T1, T2, T3]) Unpack() (T1, T2, T3) {
+	return t.First, t.Second, t.Third
+}
+
+// String implements the fmt.Stringer interface.
+func (t Tuple3[T1, T2, T3]) String() string {
+	return fmt.Sprintf("(%v, %v, %v)", t.First, t.Second, t.Third)
+}
+
+// Unpack returns the elements of the Tuple4.
+func (t Tuple4[T1, T2, T3, T4]) Unpack() (T1, T2, T3, T4) {
+	return t.First, t.Second, t.Third, t.Fourth
+}
+
+// String implements the fmt.Stringer interface.
+func (t Tuple4[T1, T2, T3, T4]) String() string {
+	return fmt.Sprintf("(%v, %v, %v, %v)", t.First, t.Second, t.Third, t.Fourth)
+}
+
+// Tuple3Equal checks if all elements of the tuples are equal.
+func Tuple3Equal[T1 comparable, T2 comparable, T3 comparable](lhs, rhs *Tuple3[T1, T2, T3]) bool {
+	return *lhs == *rhs
+}
+
+// Tuple4Equal checks if all elements of the tuples are equal.
+func Tuple4Equal[T1 comparable, T2 comparable, T3 comparable, T4 comparable](lhs, rhs *Tuple4[T1, T2, T3, T4]) bool {
+	return *lhs == *rhs
+}
+
+// HashTuple3 combines the hashes of the Tuple3 elements computed by the given hash functions.
+func HashTuple3[T1 any, T2 any, T3 any](t Tuple3[T1, T2, T3], hash1 func(T1) uint64, hash2 func(T2) uint64,
+	hash3 func(T3) uint64) uint64 {
+	return HashCombine(HashCombine(hash1(t.First), hash2(t.Second)), hash3(t.Third))
+}
+
+// HashTuple4 combines the hashes of the Tuple4 elements computed by the given hash functions.
+func HashTuple4[T1 any, T2 any, T3 any, T4 any](t Tuple4[T1, T2, T3, T4], hash1 func(T1) uint64, hash2 func(T2) uint64,
+	hash3 func(T3) uint64, hash4 func(T4) uint64) uint64 {
+	return HashCombine(HashCombine(HashCombine(hash1(t.First), hash2(t.Second)), hash3(t.Third)), hash4(t.Fourth))
+}
+
+// LexicographicTuple3 returns a Compare that orders tuples element by element,
+// moving to the next element only if the previous ones are equivalent.
+func LexicographicTuple3[T1 any, T2 any, T3 any](first Compare[T1], second Compare[T2],
+	third Compare[T3]) Compare[Tuple3[T1, T2, T3]] {
+	return CompareFunc[Tuple3[T1, T2, T3]](func(lhs, rhs Tuple3[T1, T2, T3]) bool {
+		if less, ok := lexicographicStep(first, lhs.First, rhs.First); ok {
+			return less
+		}
+
+		if less, ok := lexicographicStep(second, lhs.Second, rhs.Second); ok {
+			return less
+		}
+
+		return third.Cmp(lhs.Third, rhs.Third)
+	})
+}
+
+// LexicographicTuple4 returns a Compare that orders tuples element by element,
+// moving to the next element only if the previous ones are equivalent.
+func LexicographicTuple4[T1 any, T2 any, T3 any, T4 any](first Compare[T1], second Compare[T2],
+	third Compare[T3], fourth Compare[T4]) Compare[Tuple4[T1, T2, T3, T4]] {
+	return CompareFunc[Tuple4[T1, T2, T3, T4]](func(lhs, rhs Tuple4[T1, T2, T3, T4]) bool {
+		if less, ok := lexicographicStep(first, lhs.First, rhs.First); ok {
+			return less
+		}
+
+		if less, ok := lexicographicStep(second, lhs.Second, rhs.Second); ok {
+			return less
+		}
+
+		if less, ok := lexicographicStep(third, lhs.Third, rhs.Third); ok {
+			return less
+		}
+
+		return fourth.Cmp(lhs.Fourth, rhs.Fourth)
+	})
+}
+
+func (*Tuple3Less[T1, T2, T3]) Cmp(lhs Tuple3[T1, T2, T3], rhs Tuple3[T1, T2, T3]) bool {
+	return LexicographicTuple3[T1, T2, T3](&Less[T1]{}, &Less[T2]{}, &Less[T3]{}).Cmp(lhs, rhs)
+}
+
+func (*Tuple3Greater[T1, T2, T3]) Cmp(lhs Tuple3[T1, T2, T3], rhs Tuple3[T1, T2, T3]) bool {
+	return LexicographicTuple3[T1, T2, T3](&Greater[T1]{}, &Greater[T2]{}, &Greater[T3]{}).Cmp(lhs, rhs)
+}
+
+func (*Tuple4Less[T1, T2, T3, T4]) Cmp(lhs Tuple4[T1, T2, T3, T4], rhs Tuple4[T1, T2, T3, T4]) bool {
+	return LexicographicTuple4[T1, T2, T3, T4](&Less[T1]{}, &Less[T2]{}, &Less[T3]{}, &Less[T4]{}).Cmp(lhs, rhs)
+}
+
+func (*Tuple4Greater[T1, T2, T3, T4]) Cmp(lhs Tuple4[T1, T2, T3, T4], rhs Tuple4[T1, T2, T3, T4]) bool {
+	return LexicographicTuple4[T1, T2, T3, T4](&Greater[T1]{}, &Greater[T2]{}, &Greater[T3]{}, &Greater[T4]{}).Cmp(lhs, rhs)
+}
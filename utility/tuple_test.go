@@ -0,0 +1,68 @@
+// Copyright 2021. The GTL Authors. All rights reserved.
+// https://github.com/modern-dev/gtl
+// Use of this source code is governed by the MIT
+// license that can be found in the LICENSE file.
+
+package utility
+
+import (
+	"fmt"
+	"testing"
+)
+
+func TestMakeTuple(t *testing.T) {
+	t3 := MakeTuple3(1, "two", 3.0)
+	first, second, third := t3.Unpack()
+
+	if first != 1 || second != "two" || third != 3.0 {
+		t.Errorf("expected {1, two, 3}, got {%d, %s, %v}", first, second, third)
+	}
+
+	t4 := MakeTuple4(1, "two", 3.0, '4')
+
+	if s := fmt.Sprint(*t4); s != "(1, two, 3, 52)" {
+		t.Errorf("expected (1, two, 3, 52), got %s", s)
+	}
+}
+
+func TestTupleEqual(t *testing.T) {
+	if !Tuple3Equal(MakeTuple3(1, 2, 3), MakeTuple3(1, 2, 3)) {
+		t.Errorf("expected tuples to be equal, got false instead")
+	}
+
+	if Tuple4Equal(MakeTuple4(1, 2, 3, 4), MakeTuple4(1, 2, 3, 5)) {
+		t.Errorf("expected tuples to not be equal, got true instead")
+	}
+}
+
+func TestTupleComparators(t *testing.T) {
+	cases := []struct {
+		lhs, rhs Tuple3[int, int, string]
+		less     bool
+	}{
+		{*MakeTuple3(1, 2, "c"), *MakeTuple3(1, 3, "a"), true},
+		{*MakeTuple3(1, 2, "a"), *MakeTuple3(1, 2, "b"), true},
+		{*MakeTuple3(2, 0, "a"), *MakeTuple3(1, 9, "z"), false},
+		{*MakeTuple3(1, 2, "a"), *MakeTuple3(1, 2, "a"), false},
+	}
+
+	for _, c := range cases {
+		if got := (&Tuple3Less[int, int, string]{}).Cmp(c.lhs, c.rhs); got != c.less {
+			t.Errorf("Tuple3Less.Cmp(%v, %v) = %t, want %t", c.lhs, c.rhs, got, c.less)
+		}
+
+		if got := (&Tuple3Greater[int, int, string]{}).Cmp(c.rhs, c.lhs); got != c.less {
+			t.Errorf("Tuple3Greater.Cmp(%v, %v) = %t, want %t", c.rhs, c.lhs, got, c.less)
+		}
+	}
+
+	lhs, rhs := *MakeTuple4(1, 2, 3, 4), *MakeTuple4(1, 2, 3, 5)
+
+	if !(&Tuple4Less[int, int, int, int]{}).Cmp(lhs, rhs) {
+		t.Errorf("Tuple4Less.Cmp(%v, %v) = false, want true", lhs, rhs)
+	}
+
+	if !(&Tuple4Greater[int, int, int, int]{}).Cmp(rhs, lhs) {
+		t.Errorf("Tuple4Greater.Cmp(%v, %v) = false, want true", rhs, lhs)
+	}
+}